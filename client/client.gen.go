@@ -1713,7 +1713,7 @@ type DNSSECKeyTemplateRequest struct {
 	Lifetime *int                             `json:"lifetime"`
 	Name     string                           `json:"name"`
 	Tags     *[]NestedTagRequest              `json:"tags,omitempty"`
-	Tenant   *TenantRequest                   `json:"tenant"`
+	Tenant   *int                   `json:"tenant"`
 
 	// Type * `CSK` - CSK
 	// * `KSK` - KSK
@@ -1802,7 +1802,7 @@ type DNSSECPolicyRequest struct {
 	DnskeyTtl      *int                                 `json:"dnskey_ttl"`
 
 	// KeyTemplates Key templates assigned to the policy
-	KeyTemplates             *[]BriefDNSSECKeyTemplateRequest `json:"key_templates,omitempty"`
+	KeyTemplates             *[]int `json:"key_templates,omitempty"`
 	MaxZoneTtl               *int                             `json:"max_zone_ttl"`
 	Name                     string                           `json:"name"`
 	Nsec3Iterations          *int                             `json:"nsec3_iterations"`
@@ -1822,7 +1822,7 @@ type DNSSECPolicyRequest struct {
 	// * `inactive` - Inactive
 	Status               *DNSSECPolicyRequestStatus `json:"status,omitempty"`
 	Tags                 *[]NestedTagRequest        `json:"tags,omitempty"`
-	Tenant               *TenantRequest             `json:"tenant"`
+	Tenant               *int             `json:"tenant"`
 	UseNsec3             *bool                      `json:"use_nsec3,omitempty"`
 	ZonePropagationDelay *int                       `json:"zone_propagation_delay"`
 }
@@ -1858,7 +1858,7 @@ type NameServerRequest struct {
 	Description  *string                 `json:"description,omitempty"`
 	Name         string                  `json:"name"`
 	Tags         *[]NestedTagRequest     `json:"tags,omitempty"`
-	Tenant       *TenantRequest          `json:"tenant"`
+	Tenant       *int          `json:"tenant"`
 }
 
 // NestedDNSSECPolicy Represents an object related through a ForeignKey field. On write, it accepts a primary key (PK) value or a
@@ -2420,7 +2420,7 @@ type NestedTag struct {
 // subclassed to return a full representation of the related object on read.
 type NestedTagRequest struct {
 	Color *string `json:"color,omitempty"`
-	Name  string  `json:"name"`
+	Name  string  `json:"name,omitempty"`
 	Slug  string  `json:"slug"`
 }
 
@@ -2880,7 +2880,7 @@ type PatchedRecordTemplateRequestType string
 
 // PatchedRegistrarRequest Adds support for custom fields and tags.
 type PatchedRegistrarRequest struct {
-	AbuseEmail   *openapi_types.Email    `json:"abuse_email,omitempty"`
+	AbuseEmail   *string    `json:"abuse_email,omitempty"`
 	AbusePhone   *string                 `json:"abuse_phone,omitempty"`
 	Address      *string                 `json:"address,omitempty"`
 	CustomFields *map[string]interface{} `json:"custom_fields,omitempty"`
@@ -2898,7 +2898,7 @@ type PatchedRegistrationContactRequest struct {
 	Country       *string                 `json:"country,omitempty"`
 	CustomFields  *map[string]interface{} `json:"custom_fields,omitempty"`
 	Description   *string                 `json:"description,omitempty"`
-	Email         *openapi_types.Email    `json:"email,omitempty"`
+	Email         *string    `json:"email,omitempty"`
 	Fax           *string                 `json:"fax,omitempty"`
 	FaxExt        *string                 `json:"fax_ext,omitempty"`
 	Name          *string                 `json:"name,omitempty"`
@@ -4305,7 +4305,7 @@ type RecordTemplateRequest struct {
 	// * `inactive` - Inactive
 	Status *RecordTemplateRequestStatus  `json:"status,omitempty"`
 	Tags   *[]NestedTagRequest           `json:"tags,omitempty"`
-	Tenant *RecordTemplateRequest_Tenant `json:"tenant"`
+	Tenant *int `json:"tenant"`
 	Ttl    *int                          `json:"ttl"`
 
 	// Type * `A` - A
@@ -4479,7 +4479,7 @@ type RecordTemplateRequestType string
 
 // Registrar Adds support for custom fields and tags.
 type Registrar struct {
-	AbuseEmail   *openapi_types.Email    `json:"abuse_email,omitempty"`
+	AbuseEmail   *string    `json:"abuse_email,omitempty"`
 	AbusePhone   *string                 `json:"abuse_phone,omitempty"`
 	Address      *string                 `json:"address,omitempty"`
 	Created      *time.Time              `json:"created"`
@@ -4497,7 +4497,7 @@ type Registrar struct {
 
 // RegistrarRequest Adds support for custom fields and tags.
 type RegistrarRequest struct {
-	AbuseEmail   *openapi_types.Email    `json:"abuse_email,omitempty"`
+	AbuseEmail   *string    `json:"abuse_email,omitempty"`
 	AbusePhone   *string                 `json:"abuse_phone,omitempty"`
 	Address      *string                 `json:"address,omitempty"`
 	CustomFields *map[string]interface{} `json:"custom_fields,omitempty"`
@@ -4517,7 +4517,7 @@ type RegistrationContact struct {
 	CustomFields  *map[string]interface{} `json:"custom_fields,omitempty"`
 	Description   *string                 `json:"description,omitempty"`
 	Display       *string                 `json:"display,omitempty"`
-	Email         *openapi_types.Email    `json:"email,omitempty"`
+	Email         *string    `json:"email,omitempty"`
 	Fax           *string                 `json:"fax,omitempty"`
 	FaxExt        *string                 `json:"fax_ext,omitempty"`
 	Id            *int                    `json:"id,omitempty"`
@@ -4539,7 +4539,7 @@ type RegistrationContactRequest struct {
 	Country       *string                 `json:"country,omitempty"`
 	CustomFields  *map[string]interface{} `json:"custom_fields,omitempty"`
 	Description   *string                 `json:"description,omitempty"`
-	Email         *openapi_types.Email    `json:"email,omitempty"`
+	Email         *string    `json:"email,omitempty"`
 	Fax           *string                 `json:"fax,omitempty"`
 	FaxExt        *string                 `json:"fax_ext,omitempty"`
 	Name          *string                 `json:"name,omitempty"`
@@ -4627,9 +4627,9 @@ type ViewRequest struct {
 	Name            string                  `json:"name"`
 
 	// Prefixes IPAM Prefixes assigned to the View
-	Prefixes *[]BriefPrefixRequest `json:"prefixes,omitempty"`
+	Prefixes *[]int `json:"prefixes,omitempty"`
 	Tags     *[]NestedTagRequest   `json:"tags,omitempty"`
-	Tenant   *ViewRequest_Tenant   `json:"tenant"`
+	Tenant   *int   `json:"tenant"`
 }
 
 // ViewRequestTenant0 defines model for .
@@ -4739,7 +4739,7 @@ type WritableRecordRequest struct {
 	// * `inactive` - Inactive
 	Status *WritableRecordRequestStatus  `json:"status,omitempty"`
 	Tags   *[]NestedTagRequest           `json:"tags,omitempty"`
-	Tenant *WritableRecordRequest_Tenant `json:"tenant"`
+	Tenant *int `json:"tenant"`
 	Ttl    *int                          `json:"ttl"`
 
 	// Type * `A` - A
@@ -4915,16 +4915,16 @@ type WritableRecordRequestType string
 // WritableZoneRequest Adds support for custom fields and tags.
 type WritableZoneRequest struct {
 	// AdminC Administrative contact for the domain
-	AdminC *WritableZoneRequest_AdminC `json:"admin_c,omitempty"`
+	AdminC *int `json:"admin_c"`
 
 	// BillingC Billing contact for the domain
-	BillingC     *WritableZoneRequest_BillingC `json:"billing_c,omitempty"`
+	BillingC *int `json:"billing_c"`
 	CustomFields *map[string]interface{}       `json:"custom_fields,omitempty"`
 	DefaultTtl   *int32                          `json:"default_ttl"`
 	Description  *string                       `json:"description,omitempty"`
 
 	// DnssecPolicy DNSSEC policy to apply to the zone
-	DnssecPolicy *WritableZoneRequest_DnssecPolicy `json:"dnssec_policy,omitempty"`
+	DnssecPolicy *int `json:"dnssec_policy"`
 
 	// DomainStatus * `addPeriod` - addPeriod
 	// * `autoRenewPeriod` - autoRenewPeriod
@@ -4957,13 +4957,13 @@ type WritableZoneRequest struct {
 	Name          string `json:"name"`
 
 	// Nameservers Nameservers for the zone
-	Nameservers *[]BriefNameServerRequest `json:"nameservers,omitempty"`
+	Nameservers *[]int `json:"nameservers,omitempty"`
 
 	// Registrant Registrant of the domain
-	Registrant *WritableZoneRequest_Registrant `json:"registrant,omitempty"`
+	Registrant *int `json:"registrant"`
 
 	// Registrar Registrar the domain is registered with
-	Registrar        *WritableZoneRequest_Registrar `json:"registrar,omitempty"`
+	Registrar *int `json:"registrar"`
 	RegistryDomainId *string                        `json:"registry_domain_id"`
 
 	// Rfc2317ParentManaged The parent zone for the RFC2317 zone is managed by NetBox DNS
@@ -4996,11 +4996,11 @@ type WritableZoneRequest struct {
 	Tags   *[]NestedTagRequest        `json:"tags,omitempty"`
 
 	// TechC Technical contact for the domain
-	TechC *WritableZoneRequest_TechC `json:"tech_c,omitempty"`
+	TechC *int `json:"tech_c"`
 
 	// Template Template to apply to the zone
-	Template *WritableZoneRequest_Template `json:"template,omitempty"`
-	Tenant   *WritableZoneRequest_Tenant   `json:"tenant"`
+	Template *int `json:"template,omitempty"`
+	Tenant   *int   `json:"tenant"`
 
 	// View View the zone belongs to
 	View *int `json:"view,omitempty"`
@@ -5566,37 +5566,37 @@ type ZoneTemplate struct {
 // ZoneTemplateRequest Adds support for custom fields and tags.
 type ZoneTemplateRequest struct {
 	// AdminC Administrative contact for the domain
-	AdminC *ZoneTemplateRequest_AdminC `json:"admin_c,omitempty"`
+	AdminC *int `json:"admin_c"`
 
 	// BillingC Billing contact for the domain
-	BillingC     *ZoneTemplateRequest_BillingC `json:"billing_c,omitempty"`
+	BillingC *int `json:"billing_c"`
 	CustomFields *map[string]interface{}       `json:"custom_fields,omitempty"`
 	Description  *string                       `json:"description,omitempty"`
 
 	// DnssecPolicy DNSSEC policy assigned to the zone template
-	DnssecPolicy *ZoneTemplateRequest_DnssecPolicy `json:"dnssec_policy,omitempty"`
+	DnssecPolicy *int `json:"dnssec_policy"`
 	Name         string                            `json:"name"`
 
 	// Nameservers Nameservers for the zone
-	Nameservers *[]BriefNameServerRequest `json:"nameservers,omitempty"`
+	Nameservers *[]int `json:"nameservers,omitempty"`
 
 	// RecordTemplates Record templates assigned to the zone template
-	RecordTemplates *[]NestedRecordTemplateRequest `json:"record_templates,omitempty"`
+	RecordTemplates *[]int `json:"record_templates,omitempty"`
 
 	// Registrant Registrant of the domain
-	Registrant *ZoneTemplateRequest_Registrant `json:"registrant,omitempty"`
+	Registrant *int `json:"registrant"`
 
 	// Registrar Registrar the domain is registered with
-	Registrar *ZoneTemplateRequest_Registrar `json:"registrar,omitempty"`
+	Registrar *int `json:"registrar"`
 
 	// SoaMname Primary nameserver for the zone
-	SoaMname *ZoneTemplateRequest_SoaMname `json:"soa_mname,omitempty"`
+	SoaMname *int `json:"soa_mname"`
 	SoaRname *string                       `json:"soa_rname,omitempty"`
 	Tags     *[]NestedTagRequest           `json:"tags,omitempty"`
 
 	// TechC Technical contact for the domain
-	TechC  *ZoneTemplateRequest_TechC  `json:"tech_c,omitempty"`
-	Tenant *ZoneTemplateRequest_Tenant `json:"tenant"`
+	TechC *int `json:"tech_c"`
+	Tenant *int `json:"tenant"`
 }
 
 // ZoneTemplateRequestAdminC0 defines model for .
@@ -17,3 +17,51 @@ sed -i -E -e '/^type WritableZoneRequest struct \{/,/^\}/s/View\s+\*WritableZone
 
 sed -i -E -e '/^type WritableZoneRequest struct \{/,/^\}/s/SoaMname\s+\*WritableZoneRequest_SoaMname/SoaMname *int/' \
 	client.gen.go
+
+# fix type for the template applied to the zone: the API accepts a zone template id
+sed -i -E -e '/^type WritableZoneRequest struct \{/,/^\}/s/Template\s+\*WritableZoneRequest_Template/Template *int/' \
+	client.gen.go
+
+# fix types for the DNSSEC policy, registrar and registration contacts in zone request struct:
+# the API accepts object ids, and null clears the reference
+for field in AdminC:admin_c BillingC:billing_c DnssecPolicy:dnssec_policy Registrant:registrant Registrar:registrar TechC:tech_c ; do
+  sed -i -E -e '/^type WritableZoneRequest struct \{/,/^\}/s/'${field%%:*}'\s+\*WritableZoneRequest_'${field%%:*}'\s+`json:"'${field#*:}',omitempty"`/'${field%%:*}' *int `json:"'${field#*:}'"`/' \
+	client.gen.go
+done
+
+# fix type for nameservers in zone request struct: the API accepts a list of nameserver ids
+sed -i -E -e '/^type WritableZoneRequest struct \{/,/^\}/s/Nameservers\s+\*\[\]BriefNameServerRequest/Nameservers *[]int/' \
+	client.gen.go
+
+# fix type for key templates in DNSSEC policy request struct: the API accepts a list of key template ids
+sed -i -E -e '/^type DNSSECPolicyRequest struct \{/,/^\}/s/KeyTemplates(\s+)\*\[\]BriefDNSSECKeyTemplateRequest/KeyTemplates\1*[]int/' \
+	client.gen.go
+
+# fix types for nested objects in zone template request struct: the API accepts object ids,
+# and null clears the reference
+for field in AdminC:admin_c BillingC:billing_c DnssecPolicy:dnssec_policy Registrant:registrant Registrar:registrar SoaMname:soa_mname TechC:tech_c ; do
+  sed -i -E -e '/^type ZoneTemplateRequest struct \{/,/^\}/s/'${field%%:*}'\s+\*ZoneTemplateRequest_'${field%%:*}'\s+`json:"'${field#*:}',omitempty"`/'${field%%:*}' *int `json:"'${field#*:}'"`/' \
+	client.gen.go
+done
+sed -i -E -e '/^type ZoneTemplateRequest struct \{/,/^\}/s/Nameservers\s+\*\[\]BriefNameServerRequest/Nameservers *[]int/' \
+	-e '/^type ZoneTemplateRequest struct \{/,/^\}/s/RecordTemplates\s+\*\[\]NestedRecordTemplateRequest/RecordTemplates *[]int/' \
+	client.gen.go
+
+# fix type for email addresses: Netbox returns an empty string when no address is set,
+# which fails the validation of openapi_types.Email
+sed -i -E 's/\*openapi_types\.Email(\s+`json)/*string\1/' \
+	client.gen.go
+
+# fix type for prefixes in view request struct: the API accepts a list of IPAM prefix ids
+sed -i -E -e '/^type ViewRequest struct \{/,/^\}/s/Prefixes\s+\*\[\]BriefPrefixRequest(\s+)/Prefixes *[]int\1/' \
+	client.gen.go
+
+# fix type for the tenant in request structs: the API accepts a tenant id, and null clears it
+for struct in DNSSECKeyTemplateRequest DNSSECPolicyRequest NameServerRequest RecordTemplateRequest ViewRequest WritableRecordRequest WritableZoneRequest ZoneTemplateRequest ; do
+  sed -i -E -e '/^type '$struct' struct \{/,/^\}/s/Tenant(\s+)\*[A-Za-z_]+(\s+)`json:"tenant"`/Tenant\1*int\2`json:"tenant"`/' \
+	client.gen.go
+done
+
+# fix tag requests: the API looks tags up by the fields sent, so only send the slug
+sed -i -E -e '/^type NestedTagRequest struct \{/,/^\}/s/`json:"name"`/`json:"name,omitempty"`/' \
+	client.gen.go
@@ -0,0 +1,3 @@
+data "netboxdns_dnssec_key_template" "example" {
+  name = "ksk-rsa"
+}
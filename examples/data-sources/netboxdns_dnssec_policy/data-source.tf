@@ -0,0 +1,3 @@
+data "netboxdns_dnssec_policy" "example" {
+  name = "default"
+}
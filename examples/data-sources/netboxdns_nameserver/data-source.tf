@@ -0,0 +1,7 @@
+data "netboxdns_nameserver" "example" {
+  id = 1
+}
+
+data "netboxdns_nameserver" "ns1" {
+  name = "ns1.example.com"
+}
@@ -0,0 +1,3 @@
+data "netboxdns_nameservers" "example" {
+  zone_id = netboxdns_zone.example.id
+}
@@ -0,0 +1,14 @@
+data "netboxdns_record" "example" {
+  id = 1
+}
+
+data "netboxdns_record" "www" {
+  fqdn = "www.example.com."
+  type = "A"
+}
+
+data "netboxdns_record" "mail" {
+  name      = "mail"
+  zone_name = "example.com"
+  type      = "MX"
+}
@@ -0,0 +1,3 @@
+data "netboxdns_record_template" "example" {
+  name = "www"
+}
@@ -0,0 +1,19 @@
+# CNAME records generated by NetBox in the parent zone of an RFC 2317 zone
+data "netboxdns_records" "rfc2317_cnames" {
+  zone_id = netboxdns_zone.customer_reverse.rfc2317_parent_zone_id
+  type    = "CNAME"
+  managed = true
+}
+
+# A records of the web servers of a zone, for the load balancer configuration
+data "netboxdns_records" "web" {
+  zone_id          = netboxdns_zone.example.id
+  type             = "A"
+  name_starts_with = "web"
+  tags             = ["production"]
+  status           = "active"
+}
+
+output "web_addresses" {
+  value = data.netboxdns_records.web.records_by_fqdn_type["web1.example.com./A"][*].value
+}
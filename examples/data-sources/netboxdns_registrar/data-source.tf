@@ -0,0 +1,3 @@
+data "netboxdns_registrar" "example" {
+  name = "Example Registrar, Inc."
+}
@@ -0,0 +1,3 @@
+data "netboxdns_registration_contact" "example" {
+  contact_id = "EXAMPLE-1"
+}
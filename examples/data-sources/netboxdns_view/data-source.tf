@@ -0,0 +1,7 @@
+data "netboxdns_view" "example" {
+  id = 1
+}
+
+data "netboxdns_view" "internal" {
+  name = "internal"
+}
@@ -0,0 +1,3 @@
+data "netboxdns_views" "internal" {
+  name_starts_with = "internal"
+}
@@ -0,0 +1,8 @@
+data "netboxdns_zone" "example" {
+  id = 1
+}
+
+data "netboxdns_zone" "internal" {
+  name      = "example.com"
+  view_name = "internal"
+}
@@ -0,0 +1,3 @@
+data "netboxdns_zone_template" "example" {
+  name = "default"
+}
@@ -0,0 +1,15 @@
+data "netboxdns_zones" "active" {
+  view   = "external"
+  status  = "active"
+  tags    = ["production"]
+}
+
+# CAA record in every active zone
+resource "netboxdns_record" "caa" {
+  for_each = { for zone in data.netboxdns_zones.active.zones : zone.id => zone }
+
+  name    = "@"
+  zone_id = each.value.id
+  type    = "CAA"
+  value   = "0 issue \"letsencrypt.org\""
+}
@@ -1,3 +1,17 @@
 provider "netboxdns" {
   # example configuration here
+
+  default_tags {
+    tags = ["owner-dns-team"]
+  }
+
+  default_tenant {
+    tenant_id = 1
+  }
+
+  default_custom_fields {
+    custom_fields = {
+      cost_center = jsonencode(4200)
+    }
+  }
 }
@@ -0,0 +1 @@
+terraform import netboxdns_dnssec_key_template.example 1
@@ -0,0 +1,14 @@
+resource "netboxdns_dnssec_key_template" "ksk" {
+  name      = "ksk-rsa"
+  type      = "KSK"
+  algorithm = "RSASHA256"
+  key_size  = 2048
+  lifetime  = "365d"
+}
+
+resource "netboxdns_dnssec_key_template" "zsk" {
+  name      = "zsk-ecdsa"
+  type      = "ZSK"
+  algorithm = "ECDSAP256SHA256"
+  lifetime  = "90d"
+}
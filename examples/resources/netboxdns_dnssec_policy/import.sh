@@ -0,0 +1 @@
+terraform import netboxdns_dnssec_policy.example 1
@@ -0,0 +1,16 @@
+resource "netboxdns_dnssec_policy" "default" {
+  name             = "default"
+  description      = "Default DNSSEC policy"
+  key_template_ids = [netboxdns_dnssec_key_template.ksk.id, netboxdns_dnssec_key_template.zsk.id]
+
+  dnskey_ttl          = 3600
+  signatures_validity = 1209600
+  signatures_refresh  = 432000
+
+  use_nsec3        = true
+  nsec3_iterations = 0
+  nsec3_salt_size  = 0
+
+  create_cdnskey   = true
+  cds_digest_types = ["SHA256"]
+}
@@ -0,0 +1 @@
+terraform import netboxdns_nameserver.example 1
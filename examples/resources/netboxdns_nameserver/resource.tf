@@ -0,0 +1,4 @@
+resource "netboxdns_nameserver" "ns1" {
+  name        = "ns1.example.com"
+  description = "Primary nameserver"
+}
@@ -0,0 +1 @@
+terraform import netboxdns_record.www 1
@@ -0,0 +1,37 @@
+resource "netboxdns_record" "www" {
+  name    = "www"
+  zone_id = netboxdns_zone.example.id
+  type    = "A"
+  value   = "192.0.2.1"
+  ttl     = 3600
+}
+
+# NetBox does not create the PTR record of this address
+resource "netboxdns_record" "backup" {
+  name        = "backup"
+  zone_id     = netboxdns_zone.example.id
+  type        = "A"
+  value       = "192.0.2.2"
+  disable_ptr = true
+}
+
+output "www_ptr" {
+  value = netboxdns_record.www.ptr_record_fqdn
+}
+
+resource "netboxdns_record" "web" {
+  name    = "web"
+  zone_id = netboxdns_zone.example.id
+  type    = "CNAME"
+  value   = netboxdns_record.www.fqdn
+}
+
+# The zone can be set by name instead of ID, with the name of its view when
+# zones with the same name exist in several views
+resource "netboxdns_record" "mail" {
+  name      = "mail"
+  zone_name = "example.org"
+  view_name = "internal"
+  type      = "A"
+  value     = "192.0.2.25"
+}
@@ -0,0 +1 @@
+terraform import netboxdns_record_template.example 1
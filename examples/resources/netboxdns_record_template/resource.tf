@@ -0,0 +1,7 @@
+resource "netboxdns_record_template" "www" {
+  name        = "www"
+  record_name = "www"
+  type        = "A"
+  value       = "192.0.2.10"
+  ttl         = 3600
+}
@@ -0,0 +1 @@
+terraform import netboxdns_registrar.example 1
@@ -0,0 +1,8 @@
+resource "netboxdns_registrar" "example" {
+  name         = "Example Registrar, Inc."
+  iana_id      = 9999
+  whois_server = "whois.example-registrar.com"
+  referral_url = "https://www.example-registrar.com"
+  abuse_email  = "abuse@example-registrar.com"
+  abuse_phone  = "+1.5555550100"
+}
@@ -0,0 +1 @@
+terraform import netboxdns_registration_contact.example 1
@@ -0,0 +1,11 @@
+resource "netboxdns_registration_contact" "example" {
+  contact_id   = "EXAMPLE-1"
+  name         = "Hostmaster"
+  organization = "Example Corp"
+  street       = "1 Example Street"
+  city         = "Springfield"
+  postal_code  = "12345"
+  country      = "US"
+  phone        = "+1.5555550123"
+  email        = "hostmaster@example.com"
+}
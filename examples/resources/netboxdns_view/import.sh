@@ -0,0 +1 @@
+terraform import netboxdns_view.example 1
@@ -0,0 +1,13 @@
+resource "netboxdns_view" "internal" {
+  name        = "internal"
+  description = "Internal view"
+}
+
+resource "netboxdns_view" "datacenter" {
+  name              = "datacenter"
+  description       = "Records generated from the datacenter IPAM prefixes"
+  prefix_ids        = [12, 13]
+  ip_address_filter = jsonencode({
+    status = "active"
+  })
+}
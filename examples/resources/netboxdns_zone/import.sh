@@ -0,0 +1 @@
+terraform import netboxdns_zone.example 1
@@ -0,0 +1,48 @@
+resource "netboxdns_zone" "example" {
+  name           = "example.com"
+  view_id        = netboxdns_view.internal.id
+  status         = "active"
+  nameserver_ids = [netboxdns_nameserver.ns1.id]
+  default_ttl    = 3600
+  soa_mname_id   = netboxdns_nameserver.ns1.id
+  soa_rname      = "hostmaster.example.com"
+  soa_refresh    = 43200
+  soa_retry      = 7200
+  soa_expire     = 2419200
+  soa_minimum    = 3600
+
+  registrar_id  = netboxdns_registrar.example.id
+  registrant_id = netboxdns_registration_contact.example.id
+  admin_c_id    = netboxdns_registration_contact.example.id
+  tech_c_id     = netboxdns_registration_contact.example.id
+
+  dnssec_policy_name = "default"
+  inline_signing     = true
+
+  tags      = ["production"]
+  tenant_id = 1
+  custom_fields = {
+    cost_center = jsonencode(4200)
+    owner       = jsonencode("dns-team")
+  }
+}
+
+resource "netboxdns_zone" "customer" {
+  name        = "customer.example.com"
+  template_id = netboxdns_zone_template.example.id
+}
+
+resource "netboxdns_zone" "customer_reverse" {
+  name                   = "0-27.2.0.192.in-addr.arpa"
+  rfc2317_prefix         = "192.0.2.0/27"
+  rfc2317_parent_managed = true
+}
+
+# Views and nameservers can be set by name instead of ID
+resource "netboxdns_zone" "portable" {
+  name             = "example.org"
+  view_name        = "internal"
+  nameserver_names = ["ns1.example.com"]
+  soa_mname_name   = "ns1.example.com"
+  soa_rname        = "hostmaster.example.com"
+}
@@ -0,0 +1 @@
+terraform import netboxdns_zone_template.example 1
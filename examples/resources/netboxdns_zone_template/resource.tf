@@ -0,0 +1,7 @@
+resource "netboxdns_zone_template" "example" {
+  name                = "default"
+  nameserver_ids      = [netboxdns_nameserver.ns1.id]
+  soa_mname_id        = netboxdns_nameserver.ns1.id
+  soa_rname           = "hostmaster.example.com"
+  record_template_ids = [netboxdns_record_template.www.id]
+}
@@ -1,142 +1,408 @@
 package provider
 
 import (
-        "github.com/jean1/terraform-provider-netbox-dns/client"
-        "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-        "github.com/hashicorp/terraform-plugin-framework/types"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 type NestedZone struct {
-        Display types.String `tfsdk:"display"`
-        ID      types.Int64  `tfsdk:"id"`
-        Name    types.String `tfsdk:"name"`
-        URL     types.String `tfsdk:"url"`
+	Display types.String `tfsdk:"display"`
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
 }
 
 func (tfo NestedZone) ToAPIModel() client.NestedZone {
-        return client.NestedZone{
-                Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
-                Url:     tfo.URL.ValueStringPointer(),
-                Display: tfo.Display.ValueStringPointer(),
-                Name:    tfo.Name.ValueString(),
-        }
+	return client.NestedZone{
+		Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
+		Url:     tfo.URL.ValueStringPointer(),
+		Display: tfo.Display.ValueStringPointer(),
+		Name:    tfo.Name.ValueString(),
+	}
 }
 
 func NestedZoneFromAPI(resp *client.NestedZone) *NestedZone {
-        if resp == nil {
-                return nil
-        }
-        tfo := &NestedZone{}
-        tfo.ID = types.Int64Value(int64(*resp.Id))
-        tfo.Name = types.StringValue(resp.Name)
-        tfo.URL = maybeStringValue(resp.Url)
-        tfo.Display = maybeStringValue(resp.Display)
-        return tfo
+	if resp == nil {
+		return nil
+	}
+	tfo := &NestedZone{}
+	tfo.ID = types.Int64Value(int64(*resp.Id))
+	tfo.Name = types.StringValue(resp.Name)
+	tfo.URL = maybeStringValue(resp.Url)
+	tfo.Display = maybeStringValue(resp.Display)
+	return tfo
 }
 
 func (*NestedZone) SchemaAttributes() map[string]schema.Attribute {
-        return map[string]schema.Attribute{
-                "id": schema.Int64Attribute{
-                        Computed: true,
-                },
-                "name": schema.StringAttribute{
-                        Required: true,
-                },
-                "url": schema.StringAttribute{
-                        Optional: true,
-                },
-                "display": schema.StringAttribute{
-                        Computed: true,
-                },
-        }
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+		},
+		"display": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+type NestedRecord struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	FQDN   types.String `tfsdk:"fqdn"`
+	ZoneID types.Int64  `tfsdk:"zone_id"`
+	Type   types.String `tfsdk:"type"`
+	Value  types.String `tfsdk:"value"`
+}
+
+func NestedRecordFromAPI(resp *client.NestedRecord) *NestedRecord {
+	if resp == nil {
+		return nil
+	}
+	tfo := &NestedRecord{}
+	tfo.ID = maybeInt64Value(resp.Id)
+	tfo.Name = types.StringValue(resp.Name)
+	tfo.FQDN = nestedRecordFQDN(resp)
+	tfo.ZoneID = types.Int64Null()
+	if resp.Zone != nil {
+		tfo.ZoneID = maybeInt64Value(resp.Zone.Id)
+	}
+	tfo.Type = types.StringValue(string(resp.Type))
+	tfo.Value = types.StringValue(resp.Value)
+	return tfo
+}
+
+func (*NestedRecord) SchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"fqdn": schema.StringAttribute{
+			Computed: true,
+		},
+		"zone_id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"type": schema.StringAttribute{
+			Computed: true,
+		},
+		"value": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+// nestedRecordFQDN returns the absolute name of a nested record, which Netbox
+// does not include.
+func nestedRecordFQDN(resp *client.NestedRecord) types.String {
+	if resp.Zone == nil {
+		return types.StringNull()
+	}
+	zone := strings.TrimSuffix(resp.Zone.Name, ".") + "."
+	if resp.Name == "@" || resp.Name == "" {
+		return types.StringValue(zone)
+	}
+	return types.StringValue(strings.TrimSuffix(resp.Name, ".") + "." + zone)
 }
 
 type NestedView struct {
-        ID      types.Int64  `tfsdk:"id"`
-        Name    types.String `tfsdk:"name"`
-        URL     types.String `tfsdk:"url"`
-        Display types.String `tfsdk:"display"`
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
+	Display types.String `tfsdk:"display"`
 }
 
 func (tfo NestedView) ToAPIModel() client.BriefView {
-        return client.BriefView{
-                Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
-                Name:    tfo.Name.ValueString(),
-                Url:     tfo.URL.ValueStringPointer(),
-                Display: tfo.Display.ValueStringPointer(),
-        }
+	return client.BriefView{
+		Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
+		Name:    tfo.Name.ValueString(),
+		Url:     tfo.URL.ValueStringPointer(),
+		Display: tfo.Display.ValueStringPointer(),
+	}
 }
 
 func NestedViewFromAPI(resp *client.BriefView) *NestedView {
-        if resp == nil {
-                return nil
-        }
-        tfo := &NestedView{}
-        tfo.ID = types.Int64Value(int64(*resp.Id))
-        tfo.Name = types.StringValue(resp.Name)
-        tfo.URL = maybeStringValue(resp.Url)
-        tfo.Display = maybeStringValue(resp.Display)
-        return tfo
+	if resp == nil {
+		return nil
+	}
+	tfo := &NestedView{}
+	tfo.ID = types.Int64Value(int64(*resp.Id))
+	tfo.Name = types.StringValue(resp.Name)
+	tfo.URL = maybeStringValue(resp.Url)
+	tfo.Display = maybeStringValue(resp.Display)
+	return tfo
 }
 
 func (*NestedView) SchemaAttributes() map[string]schema.Attribute {
-        return map[string]schema.Attribute{
-                "id": schema.Int64Attribute{
-                        Computed: true,
-                },
-                "name": schema.StringAttribute{
-                        Required: true,
-                },
-                "url": schema.StringAttribute{
-                        Optional: true,
-                },
-                "display": schema.StringAttribute{
-                        Computed: true,
-                },
-        }
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+		},
+		"display": schema.StringAttribute{
+			Computed: true,
+		},
+	}
 }
 
 type NestedNameserver struct {
-        ID      types.Int64  `tfsdk:"id"`
-        Name    types.String `tfsdk:"name"`
-        URL     types.String `tfsdk:"url"`
-        Display types.String `tfsdk:"display"`
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
+	Display types.String `tfsdk:"display"`
 }
 
 func (tfo NestedNameserver) ToAPIModel() client.BriefNameServer {
-        return client.BriefNameServer{
-                Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
-                Name:    tfo.Name.ValueString(),
-                Url:     tfo.URL.ValueStringPointer(),
-                Display: tfo.Display.ValueStringPointer(),
-        }
+	return client.BriefNameServer{
+		Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
+		Name:    tfo.Name.ValueString(),
+		Url:     tfo.URL.ValueStringPointer(),
+		Display: tfo.Display.ValueStringPointer(),
+	}
 }
 
 func NestedNameserverFromAPI(resp *client.BriefNameServer) *NestedNameserver {
-        if resp == nil {
-                return nil
-        }
-        tfo := &NestedNameserver{}
-        tfo.ID = types.Int64Value(int64(*resp.Id))
-        tfo.Name = types.StringValue(resp.Name)
-        tfo.URL = maybeStringValue(resp.Url)
-        tfo.Display = maybeStringValue(resp.Display)
-        return tfo
+	if resp == nil {
+		return nil
+	}
+	tfo := &NestedNameserver{}
+	tfo.ID = types.Int64Value(int64(*resp.Id))
+	tfo.Name = types.StringValue(resp.Name)
+	tfo.URL = maybeStringValue(resp.Url)
+	tfo.Display = maybeStringValue(resp.Display)
+	return tfo
 }
 
 func (*NestedNameserver) SchemaAttributes() map[string]schema.Attribute {
-        return map[string]schema.Attribute{
-                "id": schema.Int64Attribute{
-                        Computed: true,
-                },
-                "name": schema.StringAttribute{
-                        Required: true,
-                },
-                "url": schema.StringAttribute{
-                        Optional: true,
-                },
-                "display": schema.StringAttribute{
-                        Computed: true,
-                },
-        }
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+		},
+		"display": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+type NestedRegistrar struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	URL     types.String `tfsdk:"url"`
+	Display types.String `tfsdk:"display"`
+}
+
+func (tfo NestedRegistrar) ToAPIModel() client.BriefRegistrar {
+	return client.BriefRegistrar{
+		Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
+		Name:    tfo.Name.ValueString(),
+		Url:     tfo.URL.ValueStringPointer(),
+		Display: tfo.Display.ValueStringPointer(),
+	}
+}
+
+func NestedRegistrarFromAPI(resp *client.BriefRegistrar) *NestedRegistrar {
+	if resp == nil {
+		return nil
+	}
+	tfo := &NestedRegistrar{}
+	tfo.ID = types.Int64Value(int64(*resp.Id))
+	tfo.Name = types.StringValue(resp.Name)
+	tfo.URL = maybeStringValue(resp.Url)
+	tfo.Display = maybeStringValue(resp.Display)
+	return tfo
+}
+
+func (*NestedRegistrar) SchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+		},
+		"display": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+type NestedRegistrationContact struct {
+	ID        types.Int64  `tfsdk:"id"`
+	ContactID types.String `tfsdk:"contact_id"`
+	Name      types.String `tfsdk:"name"`
+	URL       types.String `tfsdk:"url"`
+	Display   types.String `tfsdk:"display"`
+}
+
+func (tfo NestedRegistrationContact) ToAPIModel() client.BriefRegistrationContact {
+	return client.BriefRegistrationContact{
+		Id:        toIntPointer(tfo.ID.ValueInt64Pointer()),
+		ContactId: tfo.ContactID.ValueString(),
+		Name:      tfo.Name.ValueStringPointer(),
+		Url:       tfo.URL.ValueStringPointer(),
+		Display:   tfo.Display.ValueStringPointer(),
+	}
+}
+
+func NestedRegistrationContactFromAPI(resp *client.BriefRegistrationContact) *NestedRegistrationContact {
+	if resp == nil {
+		return nil
+	}
+	tfo := &NestedRegistrationContact{}
+	tfo.ID = types.Int64Value(int64(*resp.Id))
+	tfo.ContactID = types.StringValue(resp.ContactId)
+	tfo.Name = maybeStringValue(resp.Name)
+	tfo.URL = maybeStringValue(resp.Url)
+	tfo.Display = maybeStringValue(resp.Display)
+	return tfo
+}
+
+func (*NestedRegistrationContact) SchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"contact_id": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+		},
+		"display": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+type NestedDNSSECPolicy struct {
+	ID      types.Int64  `tfsdk:"id"`
+	Name    types.String `tfsdk:"name"`
+	Status  types.String `tfsdk:"status"`
+	URL     types.String `tfsdk:"url"`
+	Display types.String `tfsdk:"display"`
+}
+
+func (tfo NestedDNSSECPolicy) ToAPIModel() client.BriefDNSSECPolicy {
+	status := client.BriefDNSSECPolicyStatus(tfo.Status.ValueString())
+	return client.BriefDNSSECPolicy{
+		Id:      toIntPointer(tfo.ID.ValueInt64Pointer()),
+		Name:    tfo.Name.ValueString(),
+		Status:  &status,
+		Url:     tfo.URL.ValueStringPointer(),
+		Display: tfo.Display.ValueStringPointer(),
+	}
+}
+
+func NestedDNSSECPolicyFromAPI(resp *client.BriefDNSSECPolicy) *NestedDNSSECPolicy {
+	if resp == nil {
+		return nil
+	}
+	tfo := &NestedDNSSECPolicy{}
+	tfo.ID = types.Int64Value(int64(*resp.Id))
+	tfo.Name = types.StringValue(resp.Name)
+	tfo.Status = maybeStringValue((*string)(resp.Status))
+	tfo.URL = maybeStringValue(resp.Url)
+	tfo.Display = maybeStringValue(resp.Display)
+	return tfo
+}
+
+func (*NestedDNSSECPolicy) SchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"status": schema.StringAttribute{
+			Computed: true,
+		},
+		"url": schema.StringAttribute{
+			Computed: true,
+		},
+		"display": schema.StringAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func tagsDataSourceAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Slugs of the tags",
+	}
+}
+
+func tenantIDDataSourceAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the tenant",
+	}
+}
+
+func customFieldsDataSourceAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Custom fields set, with their values encoded in JSON",
+	}
+}
+
+// computedAttributes returns the attributes of a single object data source as
+// computed attributes, to describe the objects returned by a plural data
+// source. The descriptions of the lookup attributes are dropped.
+func computedAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	computed := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		if !attribute.IsOptional() && !attribute.IsRequired() {
+			computed[name] = attribute
+			continue
+		}
+		switch a := attribute.(type) {
+		case schema.Int64Attribute:
+			computed[name] = schema.Int64Attribute{Computed: true}
+		case schema.Int32Attribute:
+			computed[name] = schema.Int32Attribute{Computed: true}
+		case schema.StringAttribute:
+			computed[name] = schema.StringAttribute{Computed: true}
+		case schema.BoolAttribute:
+			computed[name] = schema.BoolAttribute{Computed: true}
+		case schema.SetAttribute:
+			computed[name] = schema.SetAttribute{Computed: true, ElementType: a.ElementType}
+		case schema.ListAttribute:
+			computed[name] = schema.ListAttribute{Computed: true, ElementType: a.ElementType}
+		default:
+			panic(fmt.Sprintf("unsupported lookup attribute %s of type %T", name, attribute))
+		}
+	}
+	return computed
 }
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Behaviors when an object was modified in Netbox since it was last read.
+const (
+	conflictFail  = "fail"
+	conflictMerge = "merge"
+)
+
+// checkUpdateConflict verifies, before an update, that the object was not
+// modified in Netbox since Terraform last read it. remote is the resource
+// model filled from the object currently in Netbox.
+//
+// Modifications of fields not managed by Terraform are accepted, like the
+// SOA serial and timestamp of a zone NetBox updates when its records change.
+// When mode is conflictFail, any modified attribute is reported as a
+// conflict. When mode is conflictMerge, only the modified attributes the
+// update would overwrite are, those already matching the plan being
+// accepted. It returns true when the update must then be restricted to the
+// changed attributes to preserve the modifications.
+func checkUpdateConflict(ctx context.Context, mode, kind string, req resource.UpdateRequest, lastUpdated types.String, current *time.Time, remote interface{}, attributes map[string]string, diags *diag.Diagnostics) bool {
+	if !modifiedSince(lastUpdated, current) {
+		return false
+	}
+	remoteState, changed := remoteChanges(ctx, kind, req.State, remote, attributes, diags)
+	if diags.HasError() {
+		return false
+	}
+	if mode == conflictMerge && len(changed) > 0 {
+		overwritten, err := changedAttributes(remoteState.Raw, req.Plan.Raw, attributes)
+		if err != nil {
+			diags.AddError("Internal Error", fmt.Sprintf("failed to compare %s with the plan: %s", kind, err))
+			return false
+		}
+		for field := range changed {
+			if _, ok := overwritten[field]; !ok {
+				delete(changed, field)
+			}
+		}
+	}
+	if len(changed) > 0 {
+		diags.AddError("Conflict", conflictMessage(mode, kind, lastUpdated, current, changed, attributes))
+		return false
+	}
+
+	tflog.Info(ctx, "keeping modifications made in Netbox", map[string]interface{}{
+		"kind":         kind,
+		"last_updated": current.Format(time.RFC3339Nano),
+	})
+	return true
+}
+
+// checkDeleteConflict verifies, before a delete, that the attributes of the
+// object were not modified in Netbox since Terraform last read it.
+// Modifications are only logged when mode is conflictMerge, or when only
+// fields not managed by Terraform changed.
+func checkDeleteConflict(ctx context.Context, mode, kind string, state tfsdk.State, lastUpdated types.String, current *time.Time, remote interface{}, attributes map[string]string, diags *diag.Diagnostics) {
+	if !modifiedSince(lastUpdated, current) {
+		return
+	}
+	if mode != conflictMerge {
+		_, changed := remoteChanges(ctx, kind, state, remote, attributes, diags)
+		if diags.HasError() {
+			return
+		}
+		if len(changed) > 0 {
+			diags.AddError("Conflict", conflictMessage(mode, kind, lastUpdated, current, changed, attributes))
+			return
+		}
+	}
+	tflog.Warn(ctx, "deleting object modified in Netbox", map[string]interface{}{
+		"kind":         kind,
+		"last_updated": current.Format(time.RFC3339Nano),
+	})
+}
+
+// modifiedSince reports whether the last_updated timestamp of the object in
+// Netbox differs from the one recorded in the state. States written before
+// the timestamp was recorded are never considered modified.
+func modifiedSince(lastUpdated types.String, current *time.Time) bool {
+	if lastUpdated.IsNull() || lastUpdated.IsUnknown() || current == nil {
+		return false
+	}
+	prior, err := time.Parse(time.RFC3339Nano, lastUpdated.ValueString())
+	if err != nil {
+		return true
+	}
+	return !prior.Equal(*current)
+}
+
+// remoteChanges converts the remote resource model to a state value, and
+// returns it with the Netbox API fields whose attribute differs from state.
+func remoteChanges(ctx context.Context, kind string, state tfsdk.State, remote interface{}, attributes map[string]string, diags *diag.Diagnostics) (tfsdk.State, map[string]tftypes.Type) {
+	remoteState := tfsdk.State{Schema: state.Schema}
+	diags.Append(remoteState.Set(ctx, remote)...)
+	if diags.HasError() {
+		return remoteState, nil
+	}
+	changed, err := changedAttributes(state.Raw, remoteState.Raw, attributes)
+	if err != nil {
+		diags.AddError("Internal Error", fmt.Sprintf("failed to compare %s with Netbox: %s", kind, err))
+	}
+	return remoteState, changed
+}
+
+func conflictMessage(mode, kind string, lastUpdated types.String, current *time.Time, changed map[string]tftypes.Type, attributes map[string]string) string {
+	names := make([]string, 0, len(changed))
+	for field := range changed {
+		names = append(names, attributes[field])
+	}
+	sort.Strings(names)
+	if mode == conflictMerge {
+		return fmt.Sprintf("The %s was modified in Netbox at %s, after Terraform last read it at %s, and the plan would overwrite the following modified attributes: %s.\n"+
+			"Refresh the state and review the plan before applying again.",
+			kind, current.Format(time.RFC3339Nano), lastUpdated.ValueString(), strings.Join(names, ", "))
+	}
+	return fmt.Sprintf("The %s was modified in Netbox at %s, after Terraform last read it at %s, and the following attributes changed: %s.\n"+
+		"Refresh the state and review the plan before applying again, or set the provider on_conflict attribute to %q to accept modifications that do not conflict with the configuration.",
+		kind, current.Format(time.RFC3339Nano), lastUpdated.ValueString(), strings.Join(names, ", "), conflictMerge)
+}
@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestModifiedSince(t *testing.T) {
+	read := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC)
+	later := read.Add(time.Second)
+
+	tests := []struct {
+		name        string
+		lastUpdated types.String
+		current     *time.Time
+		expected    bool
+	}{
+		{"same", types.StringValue(read.Format(time.RFC3339Nano)), &read, false},
+		{"other timezone", types.StringValue(read.In(time.FixedZone("CEST", 7200)).Format(time.RFC3339Nano)), &read, false},
+		{"modified", types.StringValue(read.Format(time.RFC3339Nano)), &later, true},
+		{"not recorded", types.StringNull(), &later, false},
+		{"missing in Netbox", types.StringValue(read.Format(time.RFC3339Nano)), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := modifiedSince(tt.lastUpdated, tt.current); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCheckUpdateConflict(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewViewResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	read := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	modified := read.Add(time.Minute)
+
+	prior := ViewResourceModel{
+		ID:              types.Int64Value(1),
+		Name:            types.StringValue("internal"),
+		Description:     types.StringValue("old"),
+		PrefixIDs:       types.SetNull(types.Int64Type),
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+		LastUpdated:     types.StringValue(read.Format(time.RFC3339Nano)),
+	}
+	planned := prior
+	planned.Name = types.StringValue("private")
+	planned.LastUpdated = types.StringUnknown()
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	if diags := req.Plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diags := req.State.Set(ctx, &prior); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	tests := []struct {
+		name        string
+		mode        string
+		remote      func(m *ViewResourceModel)
+		current     time.Time
+		expectError bool
+		expectKeep  bool
+	}{
+		{"unmodified", conflictFail, func(m *ViewResourceModel) {}, read, false, false},
+		{"unmanaged field modified", conflictFail, func(m *ViewResourceModel) {}, modified, false, true},
+		{"merge unmanaged field modified", conflictMerge, func(m *ViewResourceModel) {}, modified, false, true},
+		{"managed attribute modified", conflictFail, func(m *ViewResourceModel) { m.Description = types.StringValue("new") }, modified, true, false},
+		{"merge overwritten attribute", conflictMerge, func(m *ViewResourceModel) { m.Description = types.StringValue("new") }, modified, true, false},
+		{"merge same change", conflictMerge, func(m *ViewResourceModel) { m.Name = types.StringValue("private") }, modified, false, true},
+		{"fail same change", conflictFail, func(m *ViewResourceModel) { m.Name = types.StringValue("private") }, modified, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote := prior
+			tt.remote(&remote)
+			remote.LastUpdated = types.StringValue(tt.current.Format(time.RFC3339Nano))
+
+			var diags diag.Diagnostics
+			keep := checkUpdateConflict(ctx, tt.mode, "view", req, prior.LastUpdated, &tt.current, &remote, viewAPIAttributes, &diags)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("expected error %v, got %v", tt.expectError, diags)
+			}
+			if keep != tt.expectKeep {
+				t.Errorf("expected keep %v, got %v", tt.expectKeep, keep)
+			}
+		})
+	}
+}
+
+func TestCheckDeleteConflict(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewViewResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	read := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	modified := read.Add(time.Minute)
+
+	prior := ViewResourceModel{
+		ID:              types.Int64Value(1),
+		Name:            types.StringValue("internal"),
+		PrefixIDs:       types.SetNull(types.Int64Type),
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+		LastUpdated:     types.StringValue(read.Format(time.RFC3339Nano)),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &prior); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	tests := []struct {
+		name        string
+		mode        string
+		remote      func(m *ViewResourceModel)
+		current     time.Time
+		expectError bool
+	}{
+		{"unmodified", conflictFail, func(m *ViewResourceModel) {}, read, false},
+		{"unmanaged field modified", conflictFail, func(m *ViewResourceModel) {}, modified, false},
+		{"managed attribute modified", conflictFail, func(m *ViewResourceModel) { m.Description = types.StringValue("new") }, modified, true},
+		{"merge modified", conflictMerge, func(m *ViewResourceModel) { m.Description = types.StringValue("new") }, modified, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote := prior
+			tt.remote(&remote)
+			remote.LastUpdated = types.StringValue(tt.current.Format(time.RFC3339Nano))
+
+			var diags diag.Diagnostics
+			checkDeleteConflict(ctx, tt.mode, "view", state, prior.LastUpdated, &tt.current, &remote, viewAPIAttributes, &diags)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("expected error %v, got %v", tt.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestZoneSerialConflict(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewZoneResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	zone := func(serial int, lastUpdated string) ZoneResourceModel {
+		var resp client.Zone
+		if err := json.Unmarshal([]byte(fmt.Sprintf(`{"id": 4, "name": "2.0.192.in-addr.arpa", "status": "active", "soa_serial": %d, "soa_serial_auto": true, "last_updated": %q}`, serial, lastUpdated)), &resp); err != nil {
+			t.Fatal(err)
+		}
+		var m ZoneResourceModel
+		var diags diag.Diagnostics
+		m.FillFromAPIModel(ctx, &resp, &diags)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		return m
+	}
+	// NetBox increments the serial of the zone when one of its records
+	// changes, for example the PTR record of an address record
+	prior := zone(1, "2024-05-01T10:00:00Z")
+	remote := zone(2, "2024-05-01T10:01:00Z")
+	current := time.Date(2024, 5, 1, 10, 1, 0, 0, time.UTC)
+	planned := prior
+	planned.Description = types.StringValue("reverse zone")
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	if diags := req.Plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diags := req.State.Set(ctx, &prior); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var diags diag.Diagnostics
+	if keep := checkUpdateConflict(ctx, conflictFail, "zone", req, prior.LastUpdated, &current, &remote, prior.conflictAttributes(), &diags); diags.HasError() || !keep {
+		t.Errorf("expected the update to keep the serial, got %t: %s", keep, diags)
+	}
+	checkDeleteConflict(ctx, conflictFail, "zone", req.State, prior.LastUpdated, &current, &remote, prior.conflictAttributes(), &diags)
+	if diags.HasError() {
+		t.Errorf("expected the delete to proceed, got %s", diags)
+	}
+
+	// a serial managed by Terraform is still a conflict
+	prior.SOASerialAuto = types.BoolValue(false)
+	checkDeleteConflict(ctx, conflictFail, "zone", req.State, prior.LastUpdated, &current, &remote, prior.conflictAttributes(), &diags)
+	if !diags.HasError() {
+		t.Error("expected a conflict on the soa_serial attribute")
+	}
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DNSSECKeyTemplateDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DNSSECKeyTemplateDataSource{}
+
+func NewDNSSECKeyTemplateDataSource() datasource.DataSource {
+	return &DNSSECKeyTemplateDataSource{}
+}
+
+type DNSSECKeyTemplateDataSource struct {
+	client *client.Client
+}
+
+type DNSSECKeyTemplateDataSourceModel struct {
+	ID           types.Int64   `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	Description  types.String  `tfsdk:"description"`
+	Type         types.String  `tfsdk:"type"`
+	Algorithm    types.String  `tfsdk:"algorithm"`
+	KeySize      types.Int64   `tfsdk:"key_size"`
+	Lifetime     types.String  `tfsdk:"lifetime"`
+	PolicyIDs    []types.Int64 `tfsdk:"policy_ids"`
+	Tags         types.Set     `tfsdk:"tags"`
+	TenantID     types.Int64   `tfsdk:"tenant_id"`
+	CustomFields types.Map     `tfsdk:"custom_fields"`
+}
+
+func (m *DNSSECKeyTemplateDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.DNSSECKeyTemplate, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	m.Type = maybeStringValue((*string)(&resp.Type))
+	m.Algorithm = maybeStringValue((*string)(&resp.Algorithm))
+	m.KeySize = types.Int64Null()
+	if resp.KeySize != nil {
+		m.KeySize = types.Int64Value(int64(*resp.KeySize))
+	}
+	m.Lifetime = maybeDurationValue(types.StringNull(), resp.Lifetime)
+
+	m.PolicyIDs = []types.Int64{}
+	if resp.Policies != nil {
+		for _, element := range *resp.Policies {
+			m.PolicyIDs = append(m.PolicyIDs, maybeInt64Value(element.Id))
+		}
+	}
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
+}
+
+func (d *DNSSECKeyTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_key_template"
+}
+
+var dnssecKeyTemplateDataSchema = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		MarkdownDescription: "DNSSEC key template name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "DNSSEC key template description",
+	},
+	"type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `Key type, one of "CSK", "KSK" or "ZSK"`,
+	},
+	"algorithm": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Key algorithm",
+	},
+	"key_size": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Key size in bits, for RSA keys",
+	},
+	"lifetime": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `Key lifetime, as a duration such as "90d"`,
+	},
+	"policy_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: "IDs of the DNSSEC policies using the key template",
+	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
+}
+
+func (d *DNSSECKeyTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNSSEC key template data source",
+		Attributes:          dnssecKeyTemplateDataSchema,
+	}
+}
+
+func (d *DNSSECKeyTemplateDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *DNSSECKeyTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *DNSSECKeyTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSSECKeyTemplateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var keyTemplate *client.DNSSECKeyTemplate
+	if !data.ID.IsNull() {
+		keyTemplate = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		keyTemplate = d.lookupByName(ctx, data.Name, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FillFromAPIModel(ctx, keyTemplate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *DNSSECKeyTemplateDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.DNSSECKeyTemplate {
+	httpRes, err := d.client.PluginsNetboxDnsDnsseckeytemplatesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve DNSSEC key template: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsDnsseckeytemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC key template: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookupByName finds the DNSSEC key template with the given name, which must match
+// exactly one key template.
+func (d *DNSSECKeyTemplateDataSource) lookupByName(ctx context.Context, name types.String, diags *diag.Diagnostics) *client.DNSSECKeyTemplate {
+	params := client.PluginsNetboxDnsDnsseckeytemplatesListParams{
+		Name: &[]string{name.ValueString()},
+	}
+	httpRes, err := d.client.PluginsNetboxDnsDnsseckeytemplatesList(ctx, &params)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to list DNSSEC key templates: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsDnsseckeytemplatesListResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC key templates: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	switch len(res.JSON200.Results) {
+	case 0:
+		diags.AddAttributeError(path.Root("name"), "DNSSEC key template not found", fmt.Sprintf("No DNSSEC key template named %q found in Netbox", name.ValueString()))
+		return nil
+	case 1:
+		return &res.JSON200.Results[0]
+	default:
+		diags.AddAttributeError(path.Root("name"), "Multiple DNSSEC key templates found", fmt.Sprintf("%d DNSSEC key templates named %q found in Netbox", len(res.JSON200.Results), name.ValueString()))
+		return nil
+	}
+}
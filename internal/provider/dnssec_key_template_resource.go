@@ -0,0 +1,475 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSSECKeyTemplateResource{}
+var _ resource.ResourceWithImportState = &DNSSECKeyTemplateResource{}
+var _ resource.ResourceWithValidateConfig = &DNSSECKeyTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &DNSSECKeyTemplateResource{}
+
+func NewDNSSECKeyTemplateResource() resource.Resource {
+	return &DNSSECKeyTemplateResource{}
+}
+
+// DNSSECKeyTemplateResource defines the resource implementation.
+type DNSSECKeyTemplateResource struct {
+	client   *client.Client
+	provider *configuredProvider
+}
+
+// DNSSECKeyTemplateResourceModel describes the resource data model.
+type DNSSECKeyTemplateResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Type            types.String `tfsdk:"type"`
+	Algorithm       types.String `tfsdk:"algorithm"`
+	KeySize         types.Int64  `tfsdk:"key_size"`
+	Lifetime        types.String `tfsdk:"lifetime"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	TenantID        types.Int64  `tfsdk:"tenant_id"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+// dnssecKeyTemplateAPIAttributes maps Netbox API fields to DNSSEC key template resource attributes.
+var dnssecKeyTemplateAPIAttributes = map[string]string{
+	"name":          "name",
+	"description":   "description",
+	"type":          "type",
+	"algorithm":     "algorithm",
+	"key_size":      "key_size",
+	"lifetime":      "lifetime",
+	"tags":          "tags",
+	"tenant":        "tenant_id",
+	"custom_fields": "custom_fields",
+}
+
+func (m *DNSSECKeyTemplateResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.DNSSECKeyTemplateRequest {
+	p := client.DNSSECKeyTemplateRequest{}
+	p.Name = m.Name.ValueString()
+	p.Description = m.Description.ValueStringPointer()
+	p.Type = client.DNSSECKeyTemplateRequestType(m.Type.ValueString())
+	p.Algorithm = client.DNSSECKeyTemplateRequestAlgorithm(m.Algorithm.ValueString())
+	if !m.KeySize.IsNull() && !m.KeySize.IsUnknown() {
+		keySize := client.DNSSECKeyTemplateRequestKeySize(m.KeySize.ValueInt64())
+		p.KeySize = &keySize
+	}
+	p.Lifetime = fromDurationValue(m.Lifetime)
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
+
+	return p
+}
+
+func (m *DNSSECKeyTemplateResourceModel) FillFromAPIModel(ctx context.Context, resp *client.DNSSECKeyTemplate, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	m.Type = maybeStringValue((*string)(&resp.Type))
+	m.Algorithm = maybeStringValue((*string)(&resp.Algorithm))
+	m.KeySize = types.Int64Null()
+	if resp.KeySize != nil {
+		m.KeySize = types.Int64Value(int64(*resp.KeySize))
+	}
+	m.Lifetime = maybeDurationValue(m.Lifetime, resp.Lifetime)
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
+}
+
+func (r *DNSSECKeyTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_key_template"
+}
+
+func (r *DNSSECKeyTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSSEC key template resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "DNSSEC key template id in NetBox",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "DNSSEC key template name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "DNSSEC key template description",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.DNSSECKeyTemplateTypeCSK),
+						string(client.DNSSECKeyTemplateTypeKSK),
+						string(client.DNSSECKeyTemplateTypeZSK),
+					),
+				},
+				MarkdownDescription: `Key type, one of "CSK", "KSK" or "ZSK"`,
+			},
+			"algorithm": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.DNSSECKeyTemplateAlgorithmRSASHA256),
+						string(client.DNSSECKeyTemplateAlgorithmECDSAP256SHA256),
+						string(client.DNSSECKeyTemplateAlgorithmECDSAP384SHA384),
+						string(client.DNSSECKeyTemplateAlgorithmED25519),
+						string(client.DNSSECKeyTemplateAlgorithmED448),
+					),
+				},
+				MarkdownDescription: `Key algorithm, one of "RSASHA256", "ECDSAP256SHA256", "ECDSAP384SHA384", "ED25519" or "ED448"`,
+			},
+			"key_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(
+						int64(client.DNSSECKeyTemplateKeySizeN512),
+						int64(client.DNSSECKeyTemplateKeySizeN1024),
+						int64(client.DNSSECKeyTemplateKeySizeN2048),
+						int64(client.DNSSECKeyTemplateKeySizeN3072),
+						int64(client.DNSSECKeyTemplateKeySizeN4096),
+					),
+				},
+				MarkdownDescription: "Key size in bits, one of 512, 1024, 2048, 3072 or 4096. Only supported with the RSASHA256 algorithm, the other algorithms have a fixed key size.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"lifetime": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					durationValidator{},
+				},
+				MarkdownDescription: `Key lifetime, as a duration such as "90d", "1w2d" or "36h", or a number of seconds.`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":              tagsResourceAttribute("DNSSEC key template"),
+			"tags_all":          tagsAllResourceAttribute("DNSSEC key template"),
+			"tenant_id":         tenantIDResourceAttribute("DNSSEC key template"),
+			"custom_fields":     customFieldsResourceAttribute("DNSSEC key template"),
+			"custom_fields_all": customFieldsAllResourceAttribute("DNSSEC key template"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the DNSSEC key template in NetBox, used to detect modifications made outside of Terraform",
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects the key sizes Netbox refuses for the algorithm: only
+// RSA keys have a configurable size.
+func (r *DNSSECKeyTemplateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data DNSSECKeyTemplateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Algorithm.IsUnknown() || data.KeySize.IsNull() || data.KeySize.IsUnknown() {
+		return
+	}
+	if algorithm := data.Algorithm.ValueString(); algorithm != string(client.DNSSECKeyTemplateAlgorithmRSASHA256) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_size"),
+			"Invalid key size",
+			fmt.Sprintf("The key size can only be set with the %s algorithm, %s keys have a fixed size.", client.DNSSECKeyTemplateAlgorithmRSASHA256, algorithm),
+		)
+	}
+}
+
+// ModifyPlan resets the key size when the algorithm changes, instead of
+// keeping the size of the previous algorithm: Netbox computes it again for
+// RSA keys, other algorithms have none.
+func (r *DNSSECKeyTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var configKeySize types.Int64
+	var plannedAlgorithm, priorAlgorithm types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("key_size"), &configKeySize)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("algorithm"), &plannedAlgorithm)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("algorithm"), &priorAlgorithm)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !configKeySize.IsNull() || plannedAlgorithm.Equal(priorAlgorithm) {
+		return
+	}
+	keySize := types.Int64Unknown()
+	if !plannedAlgorithm.IsUnknown() && plannedAlgorithm.ValueString() != string(client.DNSSECKeyTemplateAlgorithmRSASHA256) {
+		keySize = types.Int64Null()
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("key_size"), keySize)...)
+}
+
+func (r *DNSSECKeyTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the DNSSEC key template currently in Netbox. It returns nil without
+// error when the DNSSEC key template does not exist anymore.
+func (r *DNSSECKeyTemplateResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.DNSSECKeyTemplate {
+	httpRes, err := r.client.PluginsNetboxDnsDnsseckeytemplatesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve DNSSEC key template: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsDnsseckeytemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC key template: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, dnssecKeyTemplateAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
+}
+
+func (r *DNSSECKeyTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSSECKeyTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsDnsseckeytemplatesCreate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create DNSSEC key template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnsseckeytemplatesCreateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC key template response: %s", err))
+		return
+	}
+	if res.JSON201 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, dnssecKeyTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECKeyTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSSECKeyTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Internal Error", "Missing ID value")
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsDnsseckeytemplatesRetrieve(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve DNSSEC key template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnsseckeytemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC key template: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		if removeNotFound(ctx, httpRes, resp, "DNSSEC key template", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, dnssecKeyTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECKeyTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSSECKeyTemplateResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the DNSSEC key template was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The DNSSEC key template %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "DNSSEC key template", req, state.LastUpdated, remote.LastUpdated, &current, dnssecKeyTemplateAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsDnsseckeytemplatesUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, dnssecKeyTemplateAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build DNSSEC key template update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsDnsseckeytemplatesPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update DNSSEC key template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnsseckeytemplatesUpdateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC key template response: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, dnssecKeyTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECKeyTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSSECKeyTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the DNSSEC key template was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "DNSSEC key template already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "DNSSEC key template", req.State, data.LastUpdated, remote.LastUpdated, &current, dnssecKeyTemplateAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsDnsseckeytemplatesDestroy(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy DNSSEC key template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnsseckeytemplatesDestroyResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
+		return
+	}
+	if !isDeleted(ctx, res.StatusCode(), "DNSSEC key template") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy DNSSEC key template: %s", string(res.Body)))
+		return
+	}
+}
+
+func (r *DNSSECKeyTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByInt64ID(ctx, req, resp)
+}
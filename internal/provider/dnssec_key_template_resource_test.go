@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestDNSSECKeyTemplateValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewDNSSECKeyTemplateResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := map[string]struct {
+		algorithm types.String
+		keySize   types.Int64
+		wantError bool
+	}{
+		"RSA key size":         {algorithm: types.StringValue("RSASHA256"), keySize: types.Int64Value(2048)},
+		"RSA default size":     {algorithm: types.StringValue("RSASHA256"), keySize: types.Int64Null()},
+		"ECDSA key size":       {algorithm: types.StringValue("ECDSAP256SHA256"), keySize: types.Int64Value(256), wantError: true},
+		"ED25519 default size": {algorithm: types.StringValue("ED25519"), keySize: types.Int64Null()},
+		"unknown algorithm":    {algorithm: types.StringUnknown(), keySize: types.Int64Value(2048)},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := dnssecKeyTemplateModel()
+			config.Algorithm = test.algorithm
+			config.KeySize = test.keySize
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &config); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			(&DNSSECKeyTemplateResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+			}, resp)
+			if got := resp.Diagnostics.HasError(); got != test.wantError {
+				t.Errorf("expected error %t, got %s", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDNSSECKeyTemplateModifyPlanKeySize(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewDNSSECKeyTemplateResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	prior := dnssecKeyTemplateModel()
+	prior.ID = types.Int64Value(1)
+	prior.Algorithm = types.StringValue("RSASHA256")
+	prior.KeySize = types.Int64Value(2048)
+
+	tests := map[string]struct {
+		algorithm     types.String
+		configKeySize types.Int64
+		want          types.Int64
+	}{
+		"same algorithm":             {algorithm: types.StringValue("RSASHA256"), configKeySize: types.Int64Null(), want: types.Int64Value(2048)},
+		"algorithm without key size": {algorithm: types.StringValue("ED25519"), configKeySize: types.Int64Null(), want: types.Int64Null()},
+		"unknown algorithm":          {algorithm: types.StringUnknown(), configKeySize: types.Int64Null(), want: types.Int64Unknown()},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := prior
+			config.Algorithm = test.algorithm
+			config.KeySize = test.configKeySize
+			// the key size of the prior state is kept in the plan when it
+			// is not configured
+			planned := config
+			if planned.KeySize.IsNull() {
+				planned.KeySize = prior.KeySize
+			}
+
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			configPlan := tfsdk.Plan{Schema: schemaResp.Schema}
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &planned); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if diags := configPlan.Set(ctx, &config); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if diags := state.Set(ctx, &prior); diags.HasError() {
+				t.Fatal(diags)
+			}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configPlan.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			(&DNSSECKeyTemplateResource{}).ModifyPlan(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			var got types.Int64
+			resp.Plan.GetAttribute(ctx, path.Root("key_size"), &got)
+			if !got.Equal(test.want) {
+				t.Errorf("expected key_size %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func dnssecKeyTemplateModel() DNSSECKeyTemplateResourceModel {
+	return DNSSECKeyTemplateResourceModel{
+		ID:              types.Int64Unknown(),
+		Name:            types.StringValue("ksk"),
+		Type:            types.StringValue("KSK"),
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+}
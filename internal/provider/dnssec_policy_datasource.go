@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DNSSECPolicyDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &DNSSECPolicyDataSource{}
+
+func NewDNSSECPolicyDataSource() datasource.DataSource {
+	return &DNSSECPolicyDataSource{}
+}
+
+type DNSSECPolicyDataSource struct {
+	client *client.Client
+}
+
+type DNSSECPolicyDataSourceModel struct {
+	ID                       types.Int64    `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Description              types.String   `tfsdk:"description"`
+	Status                   types.String   `tfsdk:"status"`
+	KeyTemplateIDs           []types.Int64  `tfsdk:"key_template_ids"`
+	DNSKEYTTL                types.Int64    `tfsdk:"dnskey_ttl"`
+	PurgeKeys                types.Int64    `tfsdk:"purge_keys"`
+	PublishSafety            types.Int64    `tfsdk:"publish_safety"`
+	RetireSafety             types.Int64    `tfsdk:"retire_safety"`
+	SignaturesJitter         types.Int64    `tfsdk:"signatures_jitter"`
+	SignaturesRefresh        types.Int64    `tfsdk:"signatures_refresh"`
+	SignaturesValidity       types.Int64    `tfsdk:"signatures_validity"`
+	SignaturesValidityDNSKEY types.Int64    `tfsdk:"signatures_validity_dnskey"`
+	MaxZoneTTL               types.Int64    `tfsdk:"max_zone_ttl"`
+	ZonePropagationDelay     types.Int64    `tfsdk:"zone_propagation_delay"`
+	ParentDSTTL              types.Int64    `tfsdk:"parent_ds_ttl"`
+	ParentPropagationDelay   types.Int64    `tfsdk:"parent_propagation_delay"`
+	NSEC3Iterations          types.Int64    `tfsdk:"nsec3_iterations"`
+	NSEC3SaltSize            types.Int64    `tfsdk:"nsec3_salt_size"`
+	CreateCDNSKEY            types.Bool     `tfsdk:"create_cdnskey"`
+	UseNSEC3                 types.Bool     `tfsdk:"use_nsec3"`
+	NSEC3OptOut              types.Bool     `tfsdk:"nsec3_opt_out"`
+	CDSDigestTypes           []types.String `tfsdk:"cds_digest_types"`
+	ZoneIDs                  []types.Int64  `tfsdk:"zone_ids"`
+	ZoneTemplateIDs          []types.Int64  `tfsdk:"zone_template_ids"`
+	Tags                     types.Set      `tfsdk:"tags"`
+	TenantID                 types.Int64    `tfsdk:"tenant_id"`
+	CustomFields             types.Map      `tfsdk:"custom_fields"`
+}
+
+func (m *DNSSECPolicyDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.DNSSECPolicy, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	m.Status = maybeStringValue((*string)(resp.Status))
+
+	m.KeyTemplateIDs = []types.Int64{}
+	if resp.KeyTemplates != nil {
+		for _, element := range *resp.KeyTemplates {
+			m.KeyTemplateIDs = append(m.KeyTemplateIDs, maybeInt64Value(element.Id))
+		}
+	}
+
+	m.DNSKEYTTL = maybeInt64Value(resp.DnskeyTtl)
+	m.PurgeKeys = maybeInt64Value(resp.PurgeKeys)
+	m.PublishSafety = maybeInt64Value(resp.PublishSafety)
+	m.RetireSafety = maybeInt64Value(resp.RetireSafety)
+	m.SignaturesJitter = maybeInt64Value(resp.SignaturesJitter)
+	m.SignaturesRefresh = maybeInt64Value(resp.SignaturesRefresh)
+	m.SignaturesValidity = maybeInt64Value(resp.SignaturesValidity)
+	m.SignaturesValidityDNSKEY = maybeInt64Value(resp.SignaturesValidityDnskey)
+	m.MaxZoneTTL = maybeInt64Value(resp.MaxZoneTtl)
+	m.ZonePropagationDelay = maybeInt64Value(resp.ZonePropagationDelay)
+	m.ParentDSTTL = maybeInt64Value(resp.ParentDsTtl)
+	m.ParentPropagationDelay = maybeInt64Value(resp.ParentPropagationDelay)
+	m.NSEC3Iterations = maybeInt64Value(resp.Nsec3Iterations)
+	m.NSEC3SaltSize = maybeInt64Value(resp.Nsec3SaltSize)
+	m.CreateCDNSKEY = maybeBoolValue(resp.CreateCdnskey)
+	m.UseNSEC3 = maybeBoolValue(resp.UseNsec3)
+	m.NSEC3OptOut = maybeBoolValue(resp.Nsec3OptOut)
+
+	m.CDSDigestTypes = []types.String{}
+	if resp.CdsDigestTypes != nil {
+		for _, digestType := range *resp.CdsDigestTypes {
+			m.CDSDigestTypes = append(m.CDSDigestTypes, types.StringValue(string(digestType)))
+		}
+	}
+	m.ZoneIDs = []types.Int64{}
+	if resp.Zones != nil {
+		for _, element := range *resp.Zones {
+			m.ZoneIDs = append(m.ZoneIDs, maybeInt64Value(element.Id))
+		}
+	}
+	m.ZoneTemplateIDs = []types.Int64{}
+	if resp.ZoneTemplates != nil {
+		for _, element := range *resp.ZoneTemplates {
+			m.ZoneTemplateIDs = append(m.ZoneTemplateIDs, maybeInt64Value(element.Id))
+		}
+	}
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
+}
+
+func (d *DNSSECPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_policy"
+}
+
+var dnssecPolicyDataSchema = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		MarkdownDescription: "DNSSEC policy name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "DNSSEC policy description",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `one of "active" or "inactive"`,
+	},
+	"key_template_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: "IDs of the DNSSEC key templates of the policy",
+	},
+	"dnskey_ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "TTL of the DNSKEY records, in seconds",
+	},
+	"purge_keys": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Time after which retired keys are purged, in seconds",
+	},
+	"publish_safety": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Margin added to the pre-publication time of new keys, in seconds",
+	},
+	"retire_safety": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Margin added to the post-publication time of retired keys, in seconds",
+	},
+	"signatures_jitter": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Random jitter applied to the validity of signatures, in seconds",
+	},
+	"signatures_refresh": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Time before expiration at which signatures are refreshed, in seconds",
+	},
+	"signatures_validity": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Validity of signatures, in seconds",
+	},
+	"signatures_validity_dnskey": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Validity of signatures over the DNSKEY records, in seconds",
+	},
+	"max_zone_ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Maximum TTL of the records of the zones using the policy, in seconds",
+	},
+	"zone_propagation_delay": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Time for zone updates to reach all secondary nameservers, in seconds",
+	},
+	"parent_ds_ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "TTL of the DS records in the parent zone, in seconds",
+	},
+	"parent_propagation_delay": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Time for parent zone updates to reach all its nameservers, in seconds",
+	},
+	"nsec3_iterations": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Number of additional NSEC3 hash iterations",
+	},
+	"nsec3_salt_size": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "Size of the NSEC3 salt, in octets",
+	},
+	"create_cdnskey": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Publish CDNSKEY records for the parent zone",
+	},
+	"use_nsec3": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Use NSEC3 instead of NSEC for authenticated denial of existence",
+	},
+	"nsec3_opt_out": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "Set the NSEC3 opt-out flag, skipping insecure delegations",
+	},
+	"cds_digest_types": schema.ListAttribute{
+		ElementType:         types.StringType,
+		Computed:            true,
+		MarkdownDescription: "Digest types of the CDS records published for the parent zone",
+	},
+	"zone_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: "IDs of the zones using the policy",
+	},
+	"zone_template_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: "IDs of the zone templates using the policy",
+	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
+}
+
+func (d *DNSSECPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNSSEC policy data source",
+		Attributes:          dnssecPolicyDataSchema,
+	}
+}
+
+func (d *DNSSECPolicyDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *DNSSECPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *DNSSECPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data DNSSECPolicyDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var policy *client.DNSSECPolicy
+	if !data.ID.IsNull() {
+		policy = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		policy = lookupDNSSECPolicy(ctx, d.client, data.Name.ValueString(), path.Root("name"), &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FillFromAPIModel(ctx, policy, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *DNSSECPolicyDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.DNSSECPolicy {
+	httpRes, err := d.client.PluginsNetboxDnsDnssecpoliciesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve DNSSEC policy: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsDnssecpoliciesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC policy: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookupDNSSECPolicy finds the DNSSEC policy with the given name, which must
+// match exactly one policy. Lookup failures are reported on attribute.
+func lookupDNSSECPolicy(ctx context.Context, c *client.Client, name string, attribute path.Path, diags *diag.Diagnostics) *client.DNSSECPolicy {
+	policies := listDNSSECPolicies(ctx, c, client.PluginsNetboxDnsDnssecpoliciesListParams{
+		Name: &[]string{name},
+	}, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return singleResult(policies, attribute, "DNSSEC policy", "DNSSEC policies", fmt.Sprintf("named %q", name), diags)
+}
@@ -0,0 +1,697 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DNSSECPolicyResource{}
+var _ resource.ResourceWithImportState = &DNSSECPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &DNSSECPolicyResource{}
+
+func NewDNSSECPolicyResource() resource.Resource {
+	return &DNSSECPolicyResource{}
+}
+
+// DNSSECPolicyResource defines the resource implementation.
+type DNSSECPolicyResource struct {
+	client   *client.Client
+	provider *configuredProvider
+}
+
+// DNSSECPolicyResourceModel describes the resource data model.
+type DNSSECPolicyResourceModel struct {
+	ID                       types.Int64  `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Description              types.String `tfsdk:"description"`
+	Status                   types.String `tfsdk:"status"`
+	KeyTemplateIDs           types.Set    `tfsdk:"key_template_ids"`
+	DNSKEYTTL                types.Int64  `tfsdk:"dnskey_ttl"`
+	PurgeKeys                types.Int64  `tfsdk:"purge_keys"`
+	PublishSafety            types.Int64  `tfsdk:"publish_safety"`
+	RetireSafety             types.Int64  `tfsdk:"retire_safety"`
+	SignaturesJitter         types.Int64  `tfsdk:"signatures_jitter"`
+	SignaturesRefresh        types.Int64  `tfsdk:"signatures_refresh"`
+	SignaturesValidity       types.Int64  `tfsdk:"signatures_validity"`
+	SignaturesValidityDNSKEY types.Int64  `tfsdk:"signatures_validity_dnskey"`
+	MaxZoneTTL               types.Int64  `tfsdk:"max_zone_ttl"`
+	ZonePropagationDelay     types.Int64  `tfsdk:"zone_propagation_delay"`
+	ParentDSTTL              types.Int64  `tfsdk:"parent_ds_ttl"`
+	ParentPropagationDelay   types.Int64  `tfsdk:"parent_propagation_delay"`
+	NSEC3Iterations          types.Int64  `tfsdk:"nsec3_iterations"`
+	NSEC3SaltSize            types.Int64  `tfsdk:"nsec3_salt_size"`
+	CreateCDNSKEY            types.Bool   `tfsdk:"create_cdnskey"`
+	UseNSEC3                 types.Bool   `tfsdk:"use_nsec3"`
+	NSEC3OptOut              types.Bool   `tfsdk:"nsec3_opt_out"`
+	CDSDigestTypes           types.Set    `tfsdk:"cds_digest_types"`
+	Tags                     types.Set    `tfsdk:"tags"`
+	TagsAll                  types.Set    `tfsdk:"tags_all"`
+	TenantID                 types.Int64  `tfsdk:"tenant_id"`
+	CustomFields             types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll          types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated              types.String `tfsdk:"last_updated"`
+}
+
+// dnssecPolicyAPIAttributes maps Netbox API fields to DNSSEC policy resource attributes.
+var dnssecPolicyAPIAttributes = map[string]string{
+	"name":                       "name",
+	"description":                "description",
+	"status":                     "status",
+	"key_templates":              "key_template_ids",
+	"dnskey_ttl":                 "dnskey_ttl",
+	"purge_keys":                 "purge_keys",
+	"publish_safety":             "publish_safety",
+	"retire_safety":              "retire_safety",
+	"signatures_jitter":          "signatures_jitter",
+	"signatures_refresh":         "signatures_refresh",
+	"signatures_validity":        "signatures_validity",
+	"signatures_validity_dnskey": "signatures_validity_dnskey",
+	"max_zone_ttl":               "max_zone_ttl",
+	"zone_propagation_delay":     "zone_propagation_delay",
+	"parent_ds_ttl":              "parent_ds_ttl",
+	"parent_propagation_delay":   "parent_propagation_delay",
+	"nsec3_iterations":           "nsec3_iterations",
+	"nsec3_salt_size":            "nsec3_salt_size",
+	"create_cdnskey":             "create_cdnskey",
+	"use_nsec3":                  "use_nsec3",
+	"nsec3_opt_out":              "nsec3_opt_out",
+	"cds_digest_types":           "cds_digest_types",
+	"tags":                       "tags",
+	"tenant":                     "tenant_id",
+	"custom_fields":              "custom_fields",
+}
+
+func (m *DNSSECPolicyResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.DNSSECPolicyRequest {
+	p := client.DNSSECPolicyRequest{}
+	p.Name = m.Name.ValueString()
+	p.Description = m.Description.ValueStringPointer()
+	if !m.Status.IsNull() && !m.Status.IsUnknown() {
+		status := client.DNSSECPolicyRequestStatus(m.Status.ValueString())
+		p.Status = &status
+	}
+	if !m.KeyTemplateIDs.IsNull() && !m.KeyTemplateIDs.IsUnknown() {
+		keyTemplates, ds := toIntSetPointer(ctx, m.KeyTemplateIDs)
+		for _, d := range ds {
+			diags.Append(diag.WithPath(path.Root("key_template_ids"), d))
+		}
+		p.KeyTemplates = &keyTemplates
+	}
+	p.DnskeyTtl = fromInt64Value(m.DNSKEYTTL)
+	p.PurgeKeys = fromInt64Value(m.PurgeKeys)
+	p.PublishSafety = fromInt64Value(m.PublishSafety)
+	p.RetireSafety = fromInt64Value(m.RetireSafety)
+	p.SignaturesJitter = fromInt64Value(m.SignaturesJitter)
+	p.SignaturesRefresh = fromInt64Value(m.SignaturesRefresh)
+	p.SignaturesValidity = fromInt64Value(m.SignaturesValidity)
+	p.SignaturesValidityDnskey = fromInt64Value(m.SignaturesValidityDNSKEY)
+	p.MaxZoneTtl = fromInt64Value(m.MaxZoneTTL)
+	p.ZonePropagationDelay = fromInt64Value(m.ZonePropagationDelay)
+	p.ParentDsTtl = fromInt64Value(m.ParentDSTTL)
+	p.ParentPropagationDelay = fromInt64Value(m.ParentPropagationDelay)
+	p.Nsec3Iterations = fromInt64Value(m.NSEC3Iterations)
+	p.Nsec3SaltSize = fromInt64Value(m.NSEC3SaltSize)
+	p.CreateCdnskey = fromBoolValue(m.CreateCDNSKEY)
+	p.UseNsec3 = fromBoolValue(m.UseNSEC3)
+	p.Nsec3OptOut = fromBoolValue(m.NSEC3OptOut)
+	if !m.CDSDigestTypes.IsNull() && !m.CDSDigestTypes.IsUnknown() {
+		var digestTypes []string
+		for _, d := range m.CDSDigestTypes.ElementsAs(ctx, &digestTypes, false) {
+			diags.Append(diag.WithPath(path.Root("cds_digest_types"), d))
+		}
+		cdsDigestTypes := make([]client.DNSSECPolicyRequestCdsDigestTypes, 0, len(digestTypes))
+		for _, digestType := range digestTypes {
+			cdsDigestTypes = append(cdsDigestTypes, client.DNSSECPolicyRequestCdsDigestTypes(digestType))
+		}
+		p.CdsDigestTypes = &cdsDigestTypes
+	}
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
+
+	return p
+}
+
+func (m *DNSSECPolicyResourceModel) FillFromAPIModel(ctx context.Context, resp *client.DNSSECPolicy, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	m.Status = maybeStringValue((*string)(resp.Status))
+
+	keyTemplates := []int64{}
+	if resp.KeyTemplates != nil {
+		for _, element := range *resp.KeyTemplates {
+			if element.Id != nil {
+				keyTemplates = append(keyTemplates, int64(*element.Id))
+			}
+		}
+	}
+	var ds diag.Diagnostics
+	m.KeyTemplateIDs, ds = types.SetValueFrom(ctx, types.Int64Type, keyTemplates)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("key_template_ids"), d))
+	}
+
+	m.DNSKEYTTL = maybeInt64Value(resp.DnskeyTtl)
+	m.PurgeKeys = maybeInt64Value(resp.PurgeKeys)
+	m.PublishSafety = maybeInt64Value(resp.PublishSafety)
+	m.RetireSafety = maybeInt64Value(resp.RetireSafety)
+	m.SignaturesJitter = maybeInt64Value(resp.SignaturesJitter)
+	m.SignaturesRefresh = maybeInt64Value(resp.SignaturesRefresh)
+	m.SignaturesValidity = maybeInt64Value(resp.SignaturesValidity)
+	m.SignaturesValidityDNSKEY = maybeInt64Value(resp.SignaturesValidityDnskey)
+	m.MaxZoneTTL = maybeInt64Value(resp.MaxZoneTtl)
+	m.ZonePropagationDelay = maybeInt64Value(resp.ZonePropagationDelay)
+	m.ParentDSTTL = maybeInt64Value(resp.ParentDsTtl)
+	m.ParentPropagationDelay = maybeInt64Value(resp.ParentPropagationDelay)
+	m.NSEC3Iterations = maybeInt64Value(resp.Nsec3Iterations)
+	m.NSEC3SaltSize = maybeInt64Value(resp.Nsec3SaltSize)
+	m.CreateCDNSKEY = maybeBoolValue(resp.CreateCdnskey)
+	m.UseNSEC3 = maybeBoolValue(resp.UseNsec3)
+	m.NSEC3OptOut = maybeBoolValue(resp.Nsec3OptOut)
+
+	digestTypes := []string{}
+	if resp.CdsDigestTypes != nil {
+		for _, digestType := range *resp.CdsDigestTypes {
+			digestTypes = append(digestTypes, string(digestType))
+		}
+	}
+	m.CDSDigestTypes, ds = types.SetValueFrom(ctx, types.StringType, digestTypes)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("cds_digest_types"), d))
+	}
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
+}
+
+func (r *DNSSECPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_policy"
+}
+
+func (r *DNSSECPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "DNSSEC policy resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "DNSSEC policy id in NetBox",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "DNSSEC policy name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "DNSSEC policy description",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(client.DNSSECPolicyStatusActive)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.DNSSECPolicyStatusActive),
+						string(client.DNSSECPolicyStatusInactive),
+					),
+				},
+				MarkdownDescription: `one of "active" or "inactive". Defaults to "active".`,
+			},
+			"key_template_ids": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IDs of the DNSSEC key templates of the policy",
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dnskey_ttl": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "TTL of the DNSKEY records, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"purge_keys": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time after which retired keys are purged, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"publish_safety": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Margin added to the pre-publication time of new keys, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"retire_safety": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Margin added to the post-publication time of retired keys, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"signatures_jitter": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Random jitter applied to the validity of signatures, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"signatures_refresh": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time before expiration at which signatures are refreshed, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"signatures_validity": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Validity of signatures, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"signatures_validity_dnskey": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Validity of signatures over the DNSKEY records, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_zone_ttl": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Maximum TTL of the records of the zones using the policy, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone_propagation_delay": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time for zone updates to reach all secondary nameservers, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_ds_ttl": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "TTL of the DS records in the parent zone, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_propagation_delay": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time for parent zone updates to reach all its nameservers, in seconds",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"nsec3_iterations": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of additional NSEC3 hash iterations",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"nsec3_salt_size": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Size of the NSEC3 salt, in octets",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"create_cdnskey": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Publish CDNSKEY records for the parent zone",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"use_nsec3": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Use NSEC3 instead of NSEC for authenticated denial of existence",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"nsec3_opt_out": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Set the NSEC3 opt-out flag, skipping insecure delegations",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cds_digest_types": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `Digest types of the CDS records published for the parent zone, among "SHA256" and "SHA384"`,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							string(client.DNSSECPolicyCdsDigestTypesSHA256),
+							string(client.DNSSECPolicyCdsDigestTypesSHA384),
+						),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":              tagsResourceAttribute("DNSSEC policy"),
+			"tags_all":          tagsAllResourceAttribute("DNSSEC policy"),
+			"tenant_id":         tenantIDResourceAttribute("DNSSEC policy"),
+			"custom_fields":     customFieldsResourceAttribute("DNSSEC policy"),
+			"custom_fields_all": customFieldsAllResourceAttribute("DNSSEC policy"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the DNSSEC policy in NetBox, used to detect modifications made outside of Terraform",
+			},
+		},
+	}
+}
+
+func (r *DNSSECPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the DNSSEC policy currently in Netbox. It returns nil without
+// error when the DNSSEC policy does not exist anymore.
+func (r *DNSSECPolicyResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.DNSSECPolicy {
+	httpRes, err := r.client.PluginsNetboxDnsDnssecpoliciesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve DNSSEC policy: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsDnssecpoliciesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC policy: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, dnssecPolicyAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
+}
+
+func (r *DNSSECPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DNSSECPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsDnssecpoliciesCreate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create DNSSEC policy: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnssecpoliciesCreateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC policy response: %s", err))
+		return
+	}
+	if res.JSON201 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, dnssecPolicyAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DNSSECPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Internal Error", "Missing ID value")
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsDnssecpoliciesRetrieve(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve DNSSEC policy: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnssecpoliciesRetrieveResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC policy: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		if removeNotFound(ctx, httpRes, resp, "DNSSEC policy", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, dnssecPolicyAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state DNSSECPolicyResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the DNSSEC policy was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The DNSSEC policy %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "DNSSEC policy", req, state.LastUpdated, remote.LastUpdated, &current, dnssecPolicyAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsDnssecpoliciesUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, dnssecPolicyAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build DNSSEC policy update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsDnssecpoliciesPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update DNSSEC policy: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnssecpoliciesUpdateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC policy response: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, dnssecPolicyAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DNSSECPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DNSSECPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the DNSSEC policy was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "DNSSEC policy already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "DNSSEC policy", req.State, data.LastUpdated, remote.LastUpdated, &current, dnssecPolicyAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsDnssecpoliciesDestroy(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy DNSSEC policy: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsDnssecpoliciesDestroyResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
+		return
+	}
+	if !isDeleted(ctx, res.StatusCode(), "DNSSEC policy") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy DNSSEC policy: %s", string(res.Body)))
+		return
+	}
+}
+
+// ModifyPlan applies the provider default tags, tenant and custom fields.
+func (r *DNSSECPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
+}
+
+func (r *DNSSECPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByInt64ID(ctx, req, resp)
+}
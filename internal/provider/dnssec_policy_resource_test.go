@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestDNSSECPolicyToAPIModel(t *testing.T) {
+	ctx := context.Background()
+	m := DNSSECPolicyResourceModel{
+		Name:            types.StringValue("default"),
+		KeyTemplateIDs:  types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)}),
+		NSEC3Iterations: types.Int64Value(0),
+		CDSDigestTypes:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("SHA256")}),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	p := m.ToAPIModel(ctx, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if p.KeyTemplates == nil || fmt.Sprint(*p.KeyTemplates) != "[3]" {
+		t.Errorf("expected key templates [3], got %v", p.KeyTemplates)
+	}
+	if p.CdsDigestTypes == nil || len(*p.CdsDigestTypes) != 1 || (*p.CdsDigestTypes)[0] != client.DNSSECPolicyRequestCdsDigestTypesSHA256 {
+		t.Errorf("expected CDS digest types [SHA256], got %v", p.CdsDigestTypes)
+	}
+	// a zero value is sent, the unset ones are left to the Netbox defaults
+	if p.Nsec3Iterations == nil || *p.Nsec3Iterations != 0 {
+		t.Errorf("expected 0 NSEC3 iterations, got %v", p.Nsec3Iterations)
+	}
+	if p.SignaturesValidity != nil {
+		t.Errorf("expected no signatures validity, got %d", *p.SignaturesValidity)
+	}
+}
+
+func TestDNSSECPolicyFillFromAPIModel(t *testing.T) {
+	ctx := context.Background()
+	var resp client.DNSSECPolicy
+	if err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"name": "default",
+		"key_templates": [{"id": 3, "name": "ksk"}, {"id": 4, "name": "zsk"}],
+		"signatures_validity": 1209600,
+		"cds_digest_types": ["SHA256", "SHA384"]
+	}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	m := DNSSECPolicyResourceModel{
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	m.FillFromAPIModel(ctx, &resp, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if want := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3), types.Int64Value(4)}); !m.KeyTemplateIDs.Equal(want) {
+		t.Errorf("expected key_template_ids %s, got %s", want, m.KeyTemplateIDs)
+	}
+	if want := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("SHA256"), types.StringValue("SHA384")}); !m.CDSDigestTypes.Equal(want) {
+		t.Errorf("expected cds_digest_types %s, got %s", want, m.CDSDigestTypes)
+	}
+	if !m.SignaturesValidity.Equal(types.Int64Value(1209600)) || !m.PurgeKeys.IsNull() {
+		t.Errorf("expected signatures_validity 1209600 and no purge_keys, got %s and %s", m.SignaturesValidity, m.PurgeKeys)
+	}
+}
+
+func TestDNSSECPolicyCDSDigestTypesValidation(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewDNSSECPolicyResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	attribute := schemaResp.Schema.Attributes["cds_digest_types"].(schema.SetAttribute)
+
+	for value, wantError := range map[string]bool{
+		string(client.DNSSECPolicyCdsDigestTypesSHA256): false,
+		string(client.DNSSECPolicyCdsDigestTypesSHA384): false,
+		"SHA-256": true,
+	} {
+		req := validator.SetRequest{
+			Path:        path.Root("cds_digest_types"),
+			ConfigValue: types.SetValueMust(types.StringType, []attr.Value{types.StringValue(value)}),
+		}
+		resp := &validator.SetResponse{}
+		for _, v := range attribute.Validators {
+			v.ValidateSet(ctx, req, resp)
+		}
+		if got := resp.Diagnostics.HasError(); got != wantError {
+			t.Errorf("%s: expected error %t, got %s", value, wantError, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+)
+
+// parseDuration parses a duration such as "90d", "1w2d" or "36h30m". On top of
+// the units accepted by time.ParseDuration, leading "w" (weeks) and "d" (days)
+// components are supported. A plain number is a number of seconds.
+func parseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+	if seconds, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, fmt.Errorf("negative duration %q", s)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	var total time.Duration
+	rest := s
+	for _, unit := range []struct {
+		suffix string
+		size   time.Duration
+	}{{"w", week}, {"d", day}} {
+		i := strings.IndexFunc(rest, func(r rune) bool { return r < '0' || r > '9' })
+		if i <= 0 || !strings.HasPrefix(rest[i:], unit.suffix) {
+			continue
+		}
+		n, err := strconv.ParseInt(rest[:i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		total += time.Duration(n) * unit.size
+		rest = rest[i+len(unit.suffix):]
+	}
+	if rest != "" {
+		d, err := time.ParseDuration(rest)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		total += d
+	}
+	if total < 0 {
+		return 0, fmt.Errorf("negative duration %q", s)
+	}
+	return total, nil
+}
+
+// formatDuration formats a number of seconds as a duration accepted by
+// parseDuration, using days as the largest unit: 7776000 gives "90d".
+func formatDuration(seconds int) string {
+	d := time.Duration(seconds) * time.Second
+	if d == 0 {
+		return "0s"
+	}
+	var b strings.Builder
+	for _, unit := range []struct {
+		suffix string
+		size   time.Duration
+	}{{"d", day}, {"h", time.Hour}, {"m", time.Minute}, {"s", time.Second}} {
+		if n := d / unit.size; n > 0 {
+			fmt.Fprintf(&b, "%d%s", n, unit.suffix)
+			d -= n * unit.size
+		}
+	}
+	return b.String()
+}
+
+// maybeDurationValue returns the duration read from Netbox as a number of
+// seconds. The prior value is kept when it is an equivalent duration, so
+// that "1w" in the configuration doesn't show as a change to "7d".
+func maybeDurationValue(prior types.String, seconds *int) types.String {
+	if seconds == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if d, err := parseDuration(prior.ValueString()); err == nil && d == time.Duration(*seconds)*time.Second {
+			return prior
+		}
+	}
+	return types.StringValue(formatDuration(*seconds))
+}
+
+// fromDurationValue converts a duration attribute to a number of seconds.
+// Values are checked by durationValidator at plan time.
+func fromDurationValue(in types.String) *int {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	d, err := parseDuration(in.ValueString())
+	if err != nil {
+		return nil
+	}
+	seconds := int(d / time.Second)
+	return &seconds
+}
+
+// durationValidator checks that a string attribute is a duration accepted by
+// parseDuration, in whole seconds.
+type durationValidator struct{}
+
+func (v durationValidator) Description(ctx context.Context) string {
+	return `value must be a duration such as "90d", "1w2d" or "36h", or a number of seconds`
+}
+
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	d, err := parseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", fmt.Sprintf("%s, got %q", v.Description(ctx), req.ConfigValue.ValueString()))
+		return
+	}
+	if d%time.Second != 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid duration", fmt.Sprintf("duration must be a whole number of seconds, got %q", req.ConfigValue.ValueString()))
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected time.Duration
+		err      bool
+	}{
+		{"3600", time.Hour, false},
+		{"90d", 90 * day, false},
+		{"1w2d", 9 * day, false},
+		{"2d12h30m", 2*day + 12*time.Hour + 30*time.Minute, false},
+		{"36h", 36 * time.Hour, false},
+		{"1d1w", 0, true},
+		{"-1h", 0, true},
+		{"-5", 0, true},
+		{"1.5d", 0, true},
+		{"", 0, true},
+		{"forever", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseDuration(tt.in)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected error %v, got %v", tt.err, err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[int]string{
+		0:        "0s",
+		45:       "45s",
+		3600:     "1h",
+		90061:    "1d1h1m1s",
+		31536000: "365d",
+	}
+	for seconds, expected := range tests {
+		if got := formatDuration(seconds); got != expected {
+			t.Errorf("%d: expected %q, got %q", seconds, expected, got)
+		}
+	}
+}
+
+func TestMaybeDurationValue(t *testing.T) {
+	seconds := int((7 * day) / time.Second)
+	if got := maybeDurationValue(types.StringValue("1w"), &seconds); got.ValueString() != "1w" {
+		t.Errorf("expected equivalent prior value to be kept, got %q", got.ValueString())
+	}
+	if got := maybeDurationValue(types.StringValue("6d"), &seconds); got.ValueString() != "7d" {
+		t.Errorf("expected %q, got %q", "7d", got.ValueString())
+	}
+	if got := maybeDurationValue(types.StringValue("7d"), nil); !got.IsNull() {
+		t.Errorf("expected null, got %q", got.ValueString())
+	}
+}
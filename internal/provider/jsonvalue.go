@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// maybeJSONValue converts a JSON value returned by Netbox to a string
+// attribute. The prior value is kept when it encodes the same value, so that
+// differences of formatting or key order are not reported as changes.
+func maybeJSONValue(prior types.String, in *interface{}) types.String {
+	if in == nil || *in == nil {
+		return types.StringNull()
+	}
+	return jsonStringValue(prior, *in)
+}
+
+// jsonStringValue encodes value, which may be nil, keeping the prior value
+// when it encodes the same value.
+func jsonStringValue(prior types.String, value interface{}) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorValue interface{}
+		if err := json.Unmarshal([]byte(prior.ValueString()), &priorValue); err == nil && reflect.DeepEqual(priorValue, value) {
+			return prior
+		}
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return types.StringNull()
+	}
+	return types.StringValue(string(encoded))
+}
+
+// fromJSONValue decodes a JSON string attribute to send it to Netbox. Values
+// are checked by jsonValidator at plan time.
+func fromJSONValue(in types.String) *interface{} {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(in.ValueString()), &value); err != nil {
+		return nil
+	}
+	return &value
+}
+
+type jsonValidator struct{}
+
+func (v jsonValidator) Description(ctx context.Context) string {
+	return "value must be a valid JSON document"
+}
+
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid JSON", fmt.Sprintf("%s, got %q", v.Description(ctx), req.ConfigValue.ValueString()))
+	}
+}
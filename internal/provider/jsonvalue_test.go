@@ -0,0 +1,30 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMaybeJSONValue(t *testing.T) {
+	var filter interface{} = map[string]interface{}{"tenant": "customer", "status": "active"}
+
+	tests := map[string]struct {
+		prior types.String
+		in    *interface{}
+		want  types.String
+	}{
+		"null":                {prior: types.StringValue(`{}`), in: nil, want: types.StringNull()},
+		"no prior value":      {prior: types.StringNull(), in: &filter, want: types.StringValue(`{"status":"active","tenant":"customer"}`)},
+		"equivalent prior":    {prior: types.StringValue(`{ "tenant": "customer", "status": "active" }`), in: &filter, want: types.StringValue(`{ "tenant": "customer", "status": "active" }`)},
+		"different prior":     {prior: types.StringValue(`{"tenant": "other"}`), in: &filter, want: types.StringValue(`{"status":"active","tenant":"customer"}`)},
+		"invalid prior value": {prior: types.StringValue(`{`), in: &filter, want: types.StringValue(`{"status":"active","tenant":"customer"}`)},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := maybeJSONValue(test.prior, test.in); !got.Equal(test.want) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
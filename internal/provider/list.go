@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// listPageSize is the number of objects requested per page when listing
+// objects in Netbox.
+const listPageSize = 1000
+
+// listAll returns all the objects of a Netbox list, requesting them page by
+// page. fetch requests the page of at most limit objects starting at offset,
+// and reports whether more pages follow.
+func listAll[T any](ctx context.Context, kind string, diags *diag.Diagnostics, fetch func(ctx context.Context, limit, offset int) ([]T, bool, error)) []T {
+	objects := []T{}
+	for {
+		page, more, err := fetch(ctx, listPageSize, len(objects))
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("failed to list %s: %s", kind, err))
+			return nil
+		}
+		objects = append(objects, page...)
+		if !more || len(page) == 0 {
+			return objects
+		}
+	}
+}
+
+// singleResult returns the only object found by a lookup. It reports an
+// error on the lookup attribute when no object or several objects match,
+// described as the kind of object, its plural and the lookup criteria.
+func singleResult[T any](objects []T, attribute path.Path, kind, plural, criteria string, diags *diag.Diagnostics) *T {
+	switch len(objects) {
+	case 0:
+		diags.AddAttributeError(attribute, kind+" not found", fmt.Sprintf("No %s %s found in Netbox", kind, criteria))
+		return nil
+	case 1:
+		return &objects[0]
+	default:
+		diags.AddAttributeError(attribute, "Multiple "+plural+" found", fmt.Sprintf("%d %s %s found in Netbox", len(objects), plural, criteria))
+		return nil
+	}
+}
+
+// listDNSSECPolicies returns all the DNSSEC policies matching params.
+func listDNSSECPolicies(ctx context.Context, c *client.Client, params client.PluginsNetboxDnsDnssecpoliciesListParams, diags *diag.Diagnostics) []client.DNSSECPolicy {
+	return listAll(ctx, "DNSSEC policies", diags, func(ctx context.Context, limit, offset int) ([]client.DNSSECPolicy, bool, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		httpRes, err := c.PluginsNetboxDnsDnssecpoliciesList(ctx, &params)
+		if err != nil {
+			return nil, false, err
+		}
+		res, err := client.ParsePluginsNetboxDnsDnssecpoliciesListResponse(httpRes)
+		if err != nil {
+			return nil, false, err
+		}
+		if res.JSON200 == nil {
+			return nil, false, errors.New(httpError(httpRes, res.Body))
+		}
+		return res.JSON200.Results, res.JSON200.Next != nil, nil
+	})
+}
+
+// listRecords returns all the DNS records matching params.
+func listRecords(ctx context.Context, c *client.Client, params client.PluginsNetboxDnsRecordsListParams, diags *diag.Diagnostics) []client.Record {
+	return listAll(ctx, "records", diags, func(ctx context.Context, limit, offset int) ([]client.Record, bool, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		httpRes, err := c.PluginsNetboxDnsRecordsList(ctx, &params)
+		if err != nil {
+			return nil, false, err
+		}
+		res, err := client.ParsePluginsNetboxDnsRecordsListResponse(httpRes)
+		if err != nil {
+			return nil, false, err
+		}
+		if res.JSON200 == nil {
+			return nil, false, errors.New(httpError(httpRes, res.Body))
+		}
+		return res.JSON200.Results, res.JSON200.Next != nil, nil
+	})
+}
+
+// listViews returns all the views matching params.
+func listViews(ctx context.Context, c *client.Client, params client.PluginsNetboxDnsViewsListParams, diags *diag.Diagnostics) []client.View {
+	return listAll(ctx, "views", diags, func(ctx context.Context, limit, offset int) ([]client.View, bool, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		httpRes, err := c.PluginsNetboxDnsViewsList(ctx, &params)
+		if err != nil {
+			return nil, false, err
+		}
+		res, err := client.ParsePluginsNetboxDnsViewsListResponse(httpRes)
+		if err != nil {
+			return nil, false, err
+		}
+		if res.JSON200 == nil {
+			return nil, false, errors.New(httpError(httpRes, res.Body))
+		}
+		return res.JSON200.Results, res.JSON200.Next != nil, nil
+	})
+}
+
+// listNameservers returns all the nameservers matching params.
+func listNameservers(ctx context.Context, c *client.Client, params client.PluginsNetboxDnsNameserversListParams, diags *diag.Diagnostics) []client.NameServer {
+	return listAll(ctx, "nameservers", diags, func(ctx context.Context, limit, offset int) ([]client.NameServer, bool, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		httpRes, err := c.PluginsNetboxDnsNameserversList(ctx, &params)
+		if err != nil {
+			return nil, false, err
+		}
+		res, err := client.ParsePluginsNetboxDnsNameserversListResponse(httpRes)
+		if err != nil {
+			return nil, false, err
+		}
+		if res.JSON200 == nil {
+			return nil, false, errors.New(httpError(httpRes, res.Body))
+		}
+		return res.JSON200.Results, res.JSON200.Next != nil, nil
+	})
+}
+
+// listZones returns all the zones matching params.
+func listZones(ctx context.Context, c *client.Client, params client.PluginsNetboxDnsZonesListParams, diags *diag.Diagnostics) []client.Zone {
+	return listAll(ctx, "zones", diags, func(ctx context.Context, limit, offset int) ([]client.Zone, bool, error) {
+		params.Limit = &limit
+		params.Offset = &offset
+		httpRes, err := c.PluginsNetboxDnsZonesList(ctx, &params)
+		if err != nil {
+			return nil, false, err
+		}
+		res, err := client.ParsePluginsNetboxDnsZonesListResponse(httpRes)
+		if err != nil {
+			return nil, false, err
+		}
+		if res.JSON200 == nil {
+			return nil, false, errors.New(httpError(httpRes, res.Body))
+		}
+		return res.JSON200.Results, res.JSON200.Next != nil, nil
+	})
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestListAll(t *testing.T) {
+	ctx := context.Background()
+	var offsets []int
+	var diags diag.Diagnostics
+	got := listAll(ctx, "zones", &diags, func(ctx context.Context, limit, offset int) ([]int, bool, error) {
+		offsets = append(offsets, offset)
+		if offset == 0 {
+			return []int{1, 2}, true, nil
+		}
+		return []int{3}, false, nil
+	})
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(got) != 3 || len(offsets) != 2 || offsets[1] != 2 {
+		t.Errorf("expected the 3 objects of both pages, got %v from offsets %v", got, offsets)
+	}
+
+	got = listAll(ctx, "zones", &diags, func(ctx context.Context, limit, offset int) ([]int, bool, error) {
+		return nil, false, errors.New("HTTP 500")
+	})
+	if got != nil || !diags.HasError() {
+		t.Errorf("expected an error, got %v", got)
+	}
+}
+
+func TestZonesDataSourceListParams(t *testing.T) {
+	data := ZonesDataSourceModel{
+		NameContains: types.StringValue("example"),
+		Status:       types.StringValue("active"),
+		ViewID:       types.Int64Value(2),
+		NameserverID: types.Int64Value(3),
+		Tags:         types.SetNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	params := data.ToListParams(context.Background(), &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	req, err := client.NewPluginsNetboxDnsZonesListRequest("http://netbox", &params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "name__ic=example&nameserver_id=3&status=active&view_id=2"
+	if got := req.URL.RawQuery; got != want {
+		t.Errorf("expected query %s, got %s", want, got)
+	}
+}
+
+func TestListRecords(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("offset") == "0" {
+			fmt.Fprintf(w, `{"count": 3, "next": "http://%s/?offset=2", "results": [{"id": 1, "name": "1", "type": "CNAME", "value": "1.0/27.2.0.192.in-addr.arpa."}, {"id": 2, "name": "2", "type": "CNAME", "value": "2.0/27.2.0.192.in-addr.arpa."}]}`, r.Host)
+			return
+		}
+		fmt.Fprint(w, `{"count": 3, "next": null, "results": [{"id": 3, "name": "3", "type": "CNAME", "value": "3.0/27.2.0.192.in-addr.arpa."}]}`)
+	}))
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	managed := true
+	var diags diag.Diagnostics
+	records := listRecords(context.Background(), c, client.PluginsNetboxDnsRecordsListParams{Managed: &managed}, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(records) != 3 || *records[2].Id != 3 {
+		t.Errorf("expected the 3 records of both pages, got %v", records)
+	}
+	if len(queries) != 2 || queries[1] != "limit=1000&managed=true&offset=2" {
+		t.Errorf("unexpected queries %v", queries)
+	}
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Tags and custom fields are only managed by a resource when they are set in
+// the configuration or in the provider defaults, so that the ones added in
+// Netbox by other tools are not removed by Terraform. The tags_all and
+// custom_fields_all attributes hold the values sent to Netbox: the provider
+// defaults merged with the values of the resource. Custom fields are managed
+// one by one: only the ones set are compared with Netbox and updated.
+
+// sentAttributes maps the tags and custom_fields attributes to the computed
+// attributes holding the values actually sent to Netbox. The *APIAttributes
+// maps point the API fields at the configured attributes, where validation
+// errors are reported, and updates compare the values sent.
+var sentAttributes = map[string]string{
+	"tags":          "tags_all",
+	"custom_fields": "custom_fields_all",
+}
+
+// tagSlugsValue returns the slugs of the tags of a Netbox object.
+func tagSlugsValue(ctx context.Context, in *[]client.NestedTag, diags *diag.Diagnostics) types.Set {
+	slugs := []string{}
+	if in != nil {
+		for _, tag := range *in {
+			slugs = append(slugs, tag.Slug)
+		}
+	}
+	value, ds := types.SetValueFrom(ctx, types.StringType, slugs)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("tags"), d))
+	}
+	return value
+}
+
+// managedTagsValue returns the tags of the prior value still set on a Netbox
+// object, or null when the tags are not managed by the resource. The other
+// tags of the object are reported in tags_all.
+func managedTagsValue(ctx context.Context, prior types.Set, in *[]client.NestedTag, diags *diag.Diagnostics) types.Set {
+	if prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	if prior.IsUnknown() {
+		return tagSlugsValue(ctx, in, diags)
+	}
+	set := map[string]bool{}
+	if in != nil {
+		for _, tag := range *in {
+			set[tag.Slug] = true
+		}
+	}
+	slugs := []string{}
+	for _, element := range prior.Elements() {
+		if slug, ok := element.(types.String); ok && set[slug.ValueString()] {
+			slugs = append(slugs, slug.ValueString())
+		}
+	}
+	value, ds := types.SetValueFrom(ctx, types.StringType, slugs)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("tags"), d))
+	}
+	return value
+}
+
+// allTagsValue returns the slugs of all the tags of a Netbox object, or null
+// when the tags are not managed by the resource.
+func allTagsValue(ctx context.Context, prior types.Set, in *[]client.NestedTag, diags *diag.Diagnostics) types.Set {
+	if prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	return tagSlugsValue(ctx, in, diags)
+}
+
+// fromTagsValue converts the tag slugs of a resource to the tags of a Netbox
+// request. Netbox looks the tags up by slug.
+func fromTagsValue(ctx context.Context, in types.Set, diags *diag.Diagnostics) *[]client.NestedTagRequest {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	var slugs []string
+	for _, d := range in.ElementsAs(ctx, &slugs, false) {
+		diags.Append(diag.WithPath(path.Root("tags"), d))
+	}
+	tags := make([]client.NestedTagRequest, len(slugs))
+	for i, slug := range slugs {
+		tags[i].Slug = slug
+	}
+	return &tags
+}
+
+// customFieldsValue returns the custom fields set on a Netbox object, with
+// their values encoded in JSON. Netbox returns all the custom fields defined
+// for the object type, with a null value when they are not set.
+func customFieldsValue(ctx context.Context, in *map[string]interface{}, diags *diag.Diagnostics) types.Map {
+	values := map[string]attr.Value{}
+	if in != nil {
+		for name, value := range *in {
+			if value != nil {
+				values[name] = jsonStringValue(types.StringNull(), value)
+			}
+		}
+	}
+	result, ds := types.MapValue(types.StringType, values)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("custom_fields"), d))
+	}
+	return result
+}
+
+// managedCustomFieldsValue returns the values in Netbox of the custom fields
+// managed by the resource, that is the ones of the prior value. The prior
+// encoding of the values is kept when they did not change.
+func managedCustomFieldsValue(ctx context.Context, prior types.Map, in *map[string]interface{}, diags *diag.Diagnostics) types.Map {
+	if prior.IsNull() || prior.IsUnknown() {
+		return types.MapNull(types.StringType)
+	}
+	values := map[string]attr.Value{}
+	if in != nil {
+		for name, element := range prior.Elements() {
+			value, ok := (*in)[name]
+			if !ok {
+				// the custom field was deleted in Netbox
+				continue
+			}
+			priorValue, _ := element.(types.String)
+			values[name] = jsonStringValue(priorValue, value)
+		}
+	}
+	result, ds := types.MapValue(types.StringType, values)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("custom_fields"), d))
+	}
+	return result
+}
+
+// fromCustomFieldsValue decodes the custom fields of a resource to send them
+// to Netbox. Values are checked by jsonValidator at plan time.
+func fromCustomFieldsValue(ctx context.Context, in types.Map, diags *diag.Diagnostics) *map[string]interface{} {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	customFields := map[string]interface{}{}
+	for name, element := range in.Elements() {
+		encoded, ok := element.(types.String)
+		if !ok || encoded.IsNull() || encoded.IsUnknown() {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(encoded.ValueString()), &value); err != nil {
+			diags.AddAttributeError(path.Root("custom_fields").AtMapKey(name), "Invalid JSON", err.Error())
+			continue
+		}
+		customFields[name] = value
+	}
+	return &customFields
+}
+
+// clearRemovedCustomFields adds to the custom fields of an update request the
+// custom fields removed from the configuration, with a null value. Netbox
+// merges the custom fields of a request with the ones already set, so the
+// removed ones would otherwise be left unchanged.
+func clearRemovedCustomFields(customFields *map[string]interface{}, prior types.Map) *map[string]interface{} {
+	if prior.IsNull() || prior.IsUnknown() {
+		return customFields
+	}
+	result := map[string]interface{}{}
+	if customFields != nil {
+		for name, value := range *customFields {
+			result[name] = value
+		}
+	}
+	for name := range prior.Elements() {
+		if _, ok := result[name]; !ok {
+			result[name] = nil
+		}
+	}
+	return &result
+}
+
+// clearRemovedTags returns an empty list of tags when the tags were removed
+// from the configuration, so that they are cleared by full updates as well.
+func clearRemovedTags(tags *[]client.NestedTagRequest, prior types.Set) *[]client.NestedTagRequest {
+	if tags == nil && !prior.IsNull() && !prior.IsUnknown() {
+		return &[]client.NestedTagRequest{}
+	}
+	return tags
+}
+
+// planMetadata sets tags_all, custom_fields_all and the default tenant in
+// the plan of a resource, from its configuration and the provider defaults.
+// It is called by the ModifyPlan method of every resource.
+func (p *configuredProvider) planMetadata(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if p == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	attributes := req.Plan.Schema.GetAttributes()
+
+	if _, ok := attributes["tags_all"]; ok {
+		var tags types.Set
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tags"), &tags)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), p.allTags(tags))...)
+	}
+
+	if _, ok := attributes["custom_fields_all"]; ok {
+		var customFields types.Map
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("custom_fields"), &customFields)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("custom_fields_all"), p.allCustomFields(customFields))...)
+	}
+
+	if _, ok := attributes["tenant_id"]; ok {
+		p.planTenant(ctx, req, resp)
+	}
+}
+
+// tenantManagedKey is the private state key recording that the tenant of a
+// resource is set by its configuration or by the provider default_tenant.
+const tenantManagedKey = "tenant_managed"
+
+// planTenant sets the default tenant in the plan of a resource, and clears
+// the tenant once removed from the configuration. tenant_id is computed to
+// show the tenant set in Netbox, so whether the tenant is managed is kept
+// in the private state of the resource.
+func (p *configuredProvider) planTenant(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var tenantID, planned types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("tenant_id"), &tenantID)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("tenant_id"), &planned)...)
+	managed, diags := req.Private.GetKey(ctx, tenantManagedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if tenantID.IsNull() && p.DefaultTenantID != nil {
+		tenantID = types.Int64Value(*p.DefaultTenantID)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tenant_id"), tenantID)...)
+	}
+	switch {
+	case !tenantID.IsNull():
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, tenantManagedKey, []byte("true"))...)
+	case managed != nil:
+		// the tenant was removed from the configuration, unless a zone
+		// template sets it
+		if !planned.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tenant_id"), types.Int64Null())...)
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, tenantManagedKey, nil)...)
+	}
+}
+
+// allTags returns the union of the default tags and of the tags of a
+// resource, or null when no tag is managed.
+func (p *configuredProvider) allTags(tags types.Set) types.Set {
+	if tags.IsUnknown() {
+		return types.SetUnknown(types.StringType)
+	}
+	if tags.IsNull() && len(p.DefaultTags) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elements := []attr.Value{}
+	seen := map[string]bool{}
+	for _, slug := range p.DefaultTags {
+		if !seen[slug] {
+			seen[slug] = true
+			elements = append(elements, types.StringValue(slug))
+		}
+	}
+	for _, element := range tags.Elements() {
+		slug, ok := element.(types.String)
+		if !ok || slug.IsUnknown() {
+			return types.SetUnknown(types.StringType)
+		}
+		if !seen[slug.ValueString()] {
+			seen[slug.ValueString()] = true
+			elements = append(elements, slug)
+		}
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// allCustomFields returns the default custom fields overridden by the custom
+// fields of a resource, or null when no custom field is managed.
+func (p *configuredProvider) allCustomFields(customFields types.Map) types.Map {
+	if customFields.IsUnknown() {
+		return types.MapUnknown(types.StringType)
+	}
+	if customFields.IsNull() && len(p.DefaultCustomFields) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	elements := map[string]attr.Value{}
+	for name, value := range p.DefaultCustomFields {
+		elements[name] = types.StringValue(value)
+	}
+	for name, value := range customFields.Elements() {
+		elements[name] = value
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func tagsResourceAttribute(kind string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Slugs of the tags of the " + kind + ", added to the provider `default_tags`. When neither is set, the tags are not managed and are left unchanged in Netbox, but removing them clears them.",
+	}
+}
+
+func tagsAllResourceAttribute(kind string) schema.SetAttribute {
+	return schema.SetAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Slugs of all the tags of the " + kind + ", including the provider `default_tags`. Null when the tags are not managed.",
+	}
+}
+
+func tenantIDResourceAttribute(kind string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "ID of the tenant of the " + kind + ". Defaults to the provider `default_tenant`. When neither is set, the tenant is not managed and is left unchanged in Netbox, but removing it clears it.",
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func customFieldsResourceAttribute(kind string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Optional:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Custom fields of the " + kind + ", with their values encoded in JSON, for example `{ cost_center = jsonencode(4200) }`: a Terraform map holds values of a single type, while custom fields can be numbers, booleans, strings, lists or objects, so `jsonencode()` keeps the type of each value sent to Netbox. Overrides the provider `default_custom_fields`. Only the custom fields set are managed, the other custom fields of the " + kind + " are left unchanged in Netbox. A custom field removed from the map is cleared.",
+		Validators: []validator.Map{
+			mapvalidator.ValueStringsAre(jsonValidator{}),
+		},
+	}
+}
+
+func customFieldsAllResourceAttribute(kind string) schema.MapAttribute {
+	return schema.MapAttribute{
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: "Custom fields managed on the " + kind + ", with their values encoded in JSON: the provider `default_custom_fields` merged with `custom_fields`. Null when no custom field is managed.",
+	}
+}
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestManagedCustomFieldsValue(t *testing.T) {
+	ctx := context.Background()
+	in := map[string]interface{}{
+		"cost_center": float64(4200),
+		"owner":       "dns-team",
+		"expires":     nil,
+		"other":       "unmanaged",
+	}
+
+	tests := map[string]struct {
+		prior types.Map
+		want  types.Map
+	}{
+		"not managed": {
+			prior: types.MapNull(types.StringType),
+			want:  types.MapNull(types.StringType),
+		},
+		"managed": {
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"cost_center": types.StringValue("4200"),
+				"owner":       types.StringValue("old-team"),
+				"expires":     types.StringValue(`"2030-01-01"`),
+				"deleted":     types.StringValue("true"),
+			}),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"cost_center": types.StringValue("4200"),
+				"owner":       types.StringValue(`"dns-team"`),
+				"expires":     types.StringValue("null"),
+			}),
+		},
+		"prior encoding kept": {
+			prior: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"cost_center": types.StringValue("4.2e3"),
+			}),
+			want: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"cost_center": types.StringValue("4.2e3"),
+			}),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			got := managedCustomFieldsValue(ctx, test.prior, &in, &diags)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestClearRemovedCustomFields(t *testing.T) {
+	prior := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"cost_center": types.StringValue("4200"),
+		"owner":       types.StringValue(`"dns-team"`),
+	})
+
+	got := clearRemovedCustomFields(&map[string]interface{}{"cost_center": float64(4300)}, prior)
+	want := map[string]interface{}{"cost_center": float64(4300), "owner": nil}
+	if got == nil || !reflect.DeepEqual(*got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	got = clearRemovedCustomFields(nil, prior)
+	want = map[string]interface{}{"cost_center": nil, "owner": nil}
+	if got == nil || !reflect.DeepEqual(*got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if got := clearRemovedCustomFields(nil, types.MapNull(types.StringType)); got != nil {
+		t.Errorf("expected nil, got %v", *got)
+	}
+}
+
+func TestFromTagsValue(t *testing.T) {
+	ctx := context.Background()
+	var diags diag.Diagnostics
+	tags := fromTagsValue(ctx, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("production")}), &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	content, err := json.Marshal(tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Netbox looks the tags up by the fields sent
+	if want := `[{"slug":"production"}]`; string(content) != want {
+		t.Errorf("expected %s, got %s", want, content)
+	}
+
+	if tags := fromTagsValue(ctx, types.SetNull(types.StringType), &diags); tags != nil {
+		t.Errorf("expected nil, got %v", *tags)
+	}
+}
+
+func TestPlanMetadata(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRecordResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tenantID := int64(5)
+	defaults := &configuredProvider{
+		DefaultTags:         []string{"owner-dns"},
+		DefaultTenantID:     &tenantID,
+		DefaultCustomFields: map[string]string{"owner": `"dns-team"`, "cost_center": "4200"},
+	}
+
+	tests := map[string]struct {
+		provider      *configuredProvider
+		tags          types.Set
+		tenantID      types.Int64
+		customFields  types.Map
+		wantTagsAll   types.Set
+		wantTenantID  types.Int64
+		wantCustomAll types.Map
+	}{
+		"defaults merged": {
+			provider:     defaults,
+			tags:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("web")}),
+			tenantID:     types.Int64Null(),
+			customFields: types.MapValueMust(types.StringType, map[string]attr.Value{"cost_center": types.StringValue("4300")}),
+			wantTagsAll:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("owner-dns"), types.StringValue("web")}),
+			wantTenantID: types.Int64Value(5),
+			wantCustomAll: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner":       types.StringValue(`"dns-team"`),
+				"cost_center": types.StringValue("4300"),
+			}),
+		},
+		"only defaults": {
+			provider:     defaults,
+			tags:         types.SetNull(types.StringType),
+			tenantID:     types.Int64Value(7),
+			customFields: types.MapNull(types.StringType),
+			wantTagsAll:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("owner-dns")}),
+			wantTenantID: types.Int64Value(7),
+			wantCustomAll: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"owner":       types.StringValue(`"dns-team"`),
+				"cost_center": types.StringValue("4200"),
+			}),
+		},
+		"not managed": {
+			provider:      &configuredProvider{},
+			tags:          types.SetNull(types.StringType),
+			tenantID:      types.Int64Null(),
+			customFields:  types.MapNull(types.StringType),
+			wantTagsAll:   types.SetNull(types.StringType),
+			wantTenantID:  types.Int64Null(),
+			wantCustomAll: types.MapNull(types.StringType),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			model := RecordResourceModel{
+				Name:            types.StringValue("www"),
+				Tags:            test.tags,
+				TagsAll:         types.SetUnknown(types.StringType),
+				TenantID:        test.tenantID,
+				CustomFields:    test.customFields,
+				CustomFieldsAll: types.MapUnknown(types.StringType),
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &model); diags.HasError() {
+				t.Fatal(diags)
+			}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			setEmptyPrivate(resp)
+
+			test.provider.planMetadata(ctx, req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatal(resp.Diagnostics)
+			}
+			var got RecordResourceModel
+			if diags := resp.Plan.Get(ctx, &got); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if !got.TagsAll.Equal(test.wantTagsAll) {
+				t.Errorf("expected tags_all %s, got %s", test.wantTagsAll, got.TagsAll)
+			}
+			if !got.TenantID.Equal(test.wantTenantID) {
+				t.Errorf("expected tenant_id %s, got %s", test.wantTenantID, got.TenantID)
+			}
+			if !got.CustomFieldsAll.Equal(test.wantCustomAll) {
+				t.Errorf("expected custom_fields_all %s, got %s", test.wantCustomAll, got.CustomFieldsAll)
+			}
+		})
+	}
+}
+
+// setEmptyPrivate gives a plan response the empty private state Terraform
+// always provides, which cannot be created outside of the framework.
+func setEmptyPrivate(resp *resource.ModifyPlanResponse) {
+	field := reflect.ValueOf(resp).Elem().FieldByName("Private")
+	field.Set(reflect.New(field.Type().Elem()))
+}
+
+func TestPlanTenant(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRecordResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	p := &configuredProvider{}
+
+	// plan returns the planned tenant_id, with the configured one and the
+	// tenant_id of the prior state kept by UseStateForUnknown
+	plan := func(config, prior types.Int64, req resource.ModifyPlanRequest) (types.Int64, resource.ModifyPlanRequest) {
+		planned := config
+		if planned.IsNull() {
+			planned = prior
+		}
+		for _, v := range []struct {
+			raw      *tftypes.Value
+			tenantID types.Int64
+		}{{&req.Config.Raw, config}, {&req.Plan.Raw, planned}} {
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			model := RecordResourceModel{
+				Name:            types.StringValue("www"),
+				Tags:            types.SetNull(types.StringType),
+				TagsAll:         types.SetNull(types.StringType),
+				TenantID:        v.tenantID,
+				CustomFields:    types.MapNull(types.StringType),
+				CustomFieldsAll: types.MapNull(types.StringType),
+			}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatal(diags)
+			}
+			*v.raw = state.Raw
+		}
+		req.Config.Schema, req.Plan.Schema = schemaResp.Schema, schemaResp.Schema
+		resp := &resource.ModifyPlanResponse{Plan: req.Plan, Private: req.Private}
+		if resp.Private == nil {
+			setEmptyPrivate(resp)
+		}
+		p.planTenant(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatal(resp.Diagnostics)
+		}
+		var got types.Int64
+		if diags := resp.Plan.GetAttribute(ctx, path.Root("tenant_id"), &got); diags.HasError() {
+			t.Fatal(diags)
+		}
+		return got, resource.ModifyPlanRequest{Private: resp.Private}
+	}
+
+	// a tenant set in Netbox is left unchanged
+	got, req := plan(types.Int64Null(), types.Int64Value(3), resource.ModifyPlanRequest{})
+	if !got.Equal(types.Int64Value(3)) {
+		t.Errorf("expected the tenant set in Netbox, got %s", got)
+	}
+	// a configured tenant is cleared once removed
+	_, req = plan(types.Int64Value(4), types.Int64Value(3), req)
+	got, req = plan(types.Int64Null(), types.Int64Value(4), req)
+	if !got.IsNull() {
+		t.Errorf("expected the removed tenant to be cleared, got %s", got)
+	}
+	// and no longer managed afterwards
+	got, _ = plan(types.Int64Null(), types.Int64Value(6), req)
+	if !got.Equal(types.Int64Value(6)) {
+		t.Errorf("expected the tenant set in Netbox, got %s", got)
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// nameCache remembers the IDs of the objects configured by name, so that
+// each name is only looked up once in Netbox by a provider instance. The
+// entries of an object are forgotten when a resource creates, renames or
+// deletes it.
+type nameCache struct {
+	mu  sync.Mutex
+	ids map[nameKey]int
+}
+
+// nameKey identifies an object looked up by name: its kind, its name and,
+// for zones, the name of their view, empty to look the zone up in all views.
+type nameKey struct {
+	kind, name, view string
+}
+
+func newNameCache() *nameCache {
+	return &nameCache{ids: map[nameKey]int{}}
+}
+
+// resolve returns the ID cached for key, or the ID found by lookup. Failed
+// lookups are not cached. A nil cache always calls lookup.
+func (c *nameCache) resolve(key nameKey, lookup func() *int) *int {
+	if c != nil {
+		c.mu.Lock()
+		id, ok := c.ids[key]
+		c.mu.Unlock()
+		if ok {
+			return &id
+		}
+	}
+
+	id := lookup()
+	if c != nil && id != nil {
+		c.mu.Lock()
+		c.ids[key] = *id
+		c.mu.Unlock()
+	}
+	return id
+}
+
+// forget removes the IDs cached for the objects of the given kind and names,
+// in all views.
+func (c *nameCache) forget(kind string, names ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.ids {
+		for _, name := range names {
+			if key.kind == kind && key.name == name {
+				delete(c.ids, key)
+			}
+		}
+	}
+}
+
+// forgetNames removes the cached IDs of the objects of the given kind named
+// by names, after a resource created, renamed or deleted one of them.
+func (p *configuredProvider) forgetNames(kind string, names ...types.String) {
+	if p == nil {
+		return
+	}
+	values := make([]string, 0, len(names))
+	for _, name := range names {
+		if !name.IsNull() && !name.IsUnknown() {
+			values = append(values, name.ValueString())
+		}
+	}
+	p.names.forget(kind, values...)
+}
+
+// viewID returns the ID of the view with the given name. Lookup failures are
+// reported on attribute. When planning, a view not found is not reported: it
+// may be created by the same apply.
+func (p *configuredProvider) viewID(ctx context.Context, name string, planning bool, attribute path.Path, diags *diag.Diagnostics) *int {
+	return p.names.resolve(nameKey{kind: "view", name: name}, func() *int {
+		params := client.PluginsNetboxDnsViewsListParams{
+			Name: &[]string{name},
+		}
+		views := listViews(ctx, p.Client, params, diags)
+		if diags.HasError() || planning && len(views) == 0 {
+			return nil
+		}
+		view := singleResult(views, attribute, "view", "views", fmt.Sprintf("named %q", name), diags)
+		if view == nil {
+			return nil
+		}
+		return view.Id
+	})
+}
+
+// nameserverID returns the ID of the nameserver with the given name, like
+// viewID.
+func (p *configuredProvider) nameserverID(ctx context.Context, name string, planning bool, attribute path.Path, diags *diag.Diagnostics) *int {
+	return p.names.resolve(nameKey{kind: "nameserver", name: name}, func() *int {
+		params := client.PluginsNetboxDnsNameserversListParams{
+			Name: &[]string{name},
+		}
+		nameservers := listNameservers(ctx, p.Client, params, diags)
+		if diags.HasError() || planning && len(nameservers) == 0 {
+			return nil
+		}
+		nameserver := singleResult(nameservers, attribute, "nameserver", "nameservers", fmt.Sprintf("named %q", name), diags)
+		if nameserver == nil {
+			return nil
+		}
+		return nameserver.Id
+	})
+}
+
+// zoneID returns the ID of the zone with the given name, in the view with the
+// given name when view is not empty, like viewID.
+func (p *configuredProvider) zoneID(ctx context.Context, name, view string, planning bool, attribute path.Path, diags *diag.Diagnostics) *int {
+	return p.names.resolve(nameKey{kind: "zone", name: name, view: view}, func() *int {
+		params := client.PluginsNetboxDnsZonesListParams{
+			Name: &[]string{name},
+		}
+		criteria := fmt.Sprintf("named %q", name)
+		if view != "" {
+			params.View = &[]string{view}
+			criteria += fmt.Sprintf(" in view %q", view)
+		}
+		zones := listZones(ctx, p.Client, params, diags)
+		if diags.HasError() || planning && len(zones) == 0 {
+			return nil
+		}
+		zone := singleResult(zones, attribute, "zone", "zones", criteria, diags)
+		if zone == nil {
+			return nil
+		}
+		return zone.Id
+	})
+}
+
+// planNamedID plans an ID attribute which can be configured by name instead,
+// in the way of the DNSSEC policy of zones. The prior ID is kept while the
+// configured name is unchanged, a new name is resolved by lookup, and the
+// name is unknown until Netbox returns it when the configured ID changes.
+// The ID is unknown when lookup finds no object, which may be created by the
+// same apply: the name is then resolved by resolveNamedID before sending the
+// object to Netbox. lookup is nil when the provider is not configured yet.
+func planNamedID(configID types.Int64, configName types.String, stateID types.Int64, stateName types.String, planID *types.Int64, planName *types.String, lookup func(name string) *int) {
+	switch {
+	case configID.IsUnknown() || configName.IsUnknown():
+		*planID = types.Int64Unknown()
+		*planName = types.StringUnknown()
+	case !configID.IsNull():
+		if !configID.Equal(stateID) {
+			*planName = types.StringUnknown()
+		}
+	case !configName.IsNull():
+		if configName.Equal(stateName) {
+			break
+		}
+		if lookup == nil {
+			*planID = types.Int64Unknown()
+			break
+		}
+		if id := lookup(configName.ValueString()); id != nil {
+			*planID = types.Int64Value(int64(*id))
+		} else {
+			*planID = types.Int64Unknown()
+		}
+	}
+}
+
+// planNamedIDs plans a set of IDs which can be configured by names instead,
+// like planNamedID.
+func planNamedIDs(ctx context.Context, configIDs, configNames, stateIDs, stateNames types.Set, planIDs, planNames *types.Set, lookup func(name string) *int, diags *diag.Diagnostics) {
+	switch {
+	case configIDs.IsUnknown() || configNames.IsUnknown():
+		*planIDs = types.SetUnknown(types.Int64Type)
+		*planNames = types.SetUnknown(types.StringType)
+	case !configIDs.IsNull():
+		if !configIDs.Equal(stateIDs) {
+			*planNames = types.SetUnknown(types.StringType)
+		}
+	case !configNames.IsNull():
+		if configNames.Equal(stateNames) {
+			break
+		}
+		if lookup == nil {
+			*planIDs = types.SetUnknown(types.Int64Type)
+			break
+		}
+		var names []types.String
+		diags.Append(configNames.ElementsAs(ctx, &names, false)...)
+		ids := []int64{}
+		for _, name := range names {
+			if name.IsUnknown() {
+				*planIDs = types.SetUnknown(types.Int64Type)
+				return
+			}
+			id := lookup(name.ValueString())
+			if id == nil {
+				*planIDs = types.SetUnknown(types.Int64Type)
+				return
+			}
+			ids = append(ids, int64(*id))
+		}
+		var ds diag.Diagnostics
+		*planIDs, ds = types.SetValueFrom(ctx, types.Int64Type, ids)
+		diags.Append(ds...)
+	}
+}
+
+// resolveNamedID resolves the ID planned unknown for a name by planNamedID.
+func resolveNamedID(id *types.Int64, name types.String, lookup func(name string) *int) {
+	if !id.IsUnknown() || name.IsNull() || name.IsUnknown() {
+		return
+	}
+	if found := lookup(name.ValueString()); found != nil {
+		*id = types.Int64Value(int64(*found))
+	}
+}
+
+// resolveNamedIDs resolves the IDs planned unknown for names by planNamedIDs.
+func resolveNamedIDs(ctx context.Context, ids *types.Set, names types.Set, lookup func(name string) *int, diags *diag.Diagnostics) {
+	if !ids.IsUnknown() || names.IsNull() || names.IsUnknown() {
+		return
+	}
+	var values []string
+	diags.Append(names.ElementsAs(ctx, &values, false)...)
+	found := []int64{}
+	for _, name := range values {
+		id := lookup(name)
+		if id == nil {
+			return
+		}
+		found = append(found, int64(*id))
+	}
+	var ds diag.Diagnostics
+	*ids, ds = types.SetValueFrom(ctx, types.Int64Type, found)
+	diags.Append(ds...)
+}
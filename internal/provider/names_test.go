@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestZoneIDCache(t *testing.T) {
+	var queries []string
+	count := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		results := ""
+		for i := 1; i <= count; i++ {
+			if i > 1 {
+				results += ", "
+			}
+			results += fmt.Sprintf(`{"id": %d, "name": "example.com"}`, i+4)
+		}
+		fmt.Fprintf(w, `{"count": %d, "next": null, "results": [%s]}`, count, results)
+	}))
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &configuredProvider{Client: c, names: newNameCache()}
+	ctx := context.Background()
+
+	var diags diag.Diagnostics
+	for i := 0; i < 2; i++ {
+		id := p.zoneID(ctx, "example.com", "internal", false, path.Root("zone_name"), &diags)
+		if diags.HasError() {
+			t.Fatal(diags)
+		}
+		if id == nil || *id != 5 {
+			t.Fatalf("expected zone 5, got %v", id)
+		}
+	}
+	if want := []string{"limit=1000&name=example.com&offset=0&view=internal"}; fmt.Sprint(queries) != fmt.Sprint(want) {
+		t.Errorf("expected queries %s, got %s", want, queries)
+	}
+
+	// failed lookups are not cached
+	count = 2
+	if id := p.zoneID(ctx, "example.com", "", false, path.Root("zone_name"), &diags); id != nil || !diags.HasError() {
+		t.Errorf("expected an error, got zone %v", id)
+	}
+	count = 1
+	diags = nil
+	if id := p.zoneID(ctx, "example.com", "", false, path.Root("zone_name"), &diags); id == nil || diags.HasError() {
+		t.Errorf("expected zone 5, got %v: %s", id, diags)
+	}
+	if len(queries) != 3 {
+		t.Errorf("expected 3 queries, got %s", queries)
+	}
+
+	// a zone not found is only reported when applying
+	count = 0
+	if id := p.zoneID(ctx, "example.net", "", true, path.Root("zone_name"), &diags); id != nil || diags.HasError() {
+		t.Errorf("expected no zone and no error when planning, got %v: %s", id, diags)
+	}
+	if id := p.zoneID(ctx, "example.net", "", false, path.Root("zone_name"), &diags); id != nil || !diags.HasError() {
+		t.Errorf("expected an error when applying, got zone %v", id)
+	}
+
+	// the zones created, renamed or deleted are looked up again
+	count = 1
+	p.forgetNames("zone", types.StringValue("example.com"))
+	diags = nil
+	p.zoneID(ctx, "example.com", "internal", false, path.Root("zone_name"), &diags)
+	if len(queries) != 6 {
+		t.Errorf("expected 6 queries, got %s", queries)
+	}
+}
+
+func TestPlanNamedID(t *testing.T) {
+	lookup := func(name string) *int {
+		id := map[string]int{"internal": 1, "external": 2}[name]
+		return &id
+	}
+
+	tests := map[string]struct {
+		configID   types.Int64
+		configName types.String
+		lookup     func(string) *int
+		wantID     types.Int64
+		wantName   types.String
+	}{
+		"same id": {
+			configID:   types.Int64Value(1),
+			configName: types.StringNull(),
+			lookup:     lookup,
+			wantID:     types.Int64Value(1),
+			wantName:   types.StringValue("internal"),
+		},
+		"new id": {
+			configID:   types.Int64Value(2),
+			configName: types.StringNull(),
+			lookup:     lookup,
+			wantID:     types.Int64Value(2),
+			wantName:   types.StringUnknown(),
+		},
+		"same name": {
+			configID:   types.Int64Null(),
+			configName: types.StringValue("internal"),
+			wantID:     types.Int64Value(1),
+			wantName:   types.StringValue("internal"),
+		},
+		"new name": {
+			configID:   types.Int64Null(),
+			configName: types.StringValue("external"),
+			lookup:     lookup,
+			wantID:     types.Int64Value(2),
+			wantName:   types.StringValue("external"),
+		},
+		"new name without client": {
+			configID:   types.Int64Null(),
+			configName: types.StringValue("external"),
+			wantID:     types.Int64Unknown(),
+			wantName:   types.StringValue("external"),
+		},
+		"name not created yet": {
+			configID:   types.Int64Null(),
+			configName: types.StringValue("external"),
+			lookup:     func(string) *int { return nil },
+			wantID:     types.Int64Unknown(),
+			wantName:   types.StringValue("external"),
+		},
+		"unknown name": {
+			configID:   types.Int64Null(),
+			configName: types.StringUnknown(),
+			lookup:     lookup,
+			wantID:     types.Int64Unknown(),
+			wantName:   types.StringUnknown(),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// the plan holds the configured values, or the prior state ones
+			// for the unset computed attributes
+			planID, planName := test.configID, test.configName
+			if planID.IsNull() {
+				planID = types.Int64Value(1)
+			}
+			if planName.IsNull() {
+				planName = types.StringValue("internal")
+			}
+			planNamedID(test.configID, test.configName, types.Int64Value(1), types.StringValue("internal"), &planID, &planName, test.lookup)
+			if !planID.Equal(test.wantID) {
+				t.Errorf("expected id %s, got %s", test.wantID, planID)
+			}
+			if !planName.Equal(test.wantName) {
+				t.Errorf("expected name %s, got %s", test.wantName, planName)
+			}
+		})
+	}
+}
+
+func TestResolveNamedID(t *testing.T) {
+	lookup := func(name string) *int {
+		id := map[string]int{"internal": 1, "external": 2}[name]
+		return &id
+	}
+
+	id := types.Int64Unknown()
+	resolveNamedID(&id, types.StringValue("external"), lookup)
+	if !id.Equal(types.Int64Value(2)) {
+		t.Errorf("expected id 2, got %s", id)
+	}
+	// the IDs known at plan time are kept
+	resolveNamedID(&id, types.StringValue("internal"), lookup)
+	if !id.Equal(types.Int64Value(2)) {
+		t.Errorf("expected id 2, got %s", id)
+	}
+
+	ids := types.SetUnknown(types.Int64Type)
+	var diags diag.Diagnostics
+	resolveNamedIDs(context.Background(), &ids, types.SetValueMust(types.StringType, []attr.Value{types.StringValue("internal"), types.StringValue("external")}), lookup, &diags)
+	if want := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(1), types.Int64Value(2)}); diags.HasError() || !ids.Equal(want) {
+		t.Errorf("expected ids %s, got %s: %s", want, ids, diags)
+	}
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &NameserverDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &NameserverDataSource{}
 
 func NewNameserverDataSource() datasource.DataSource {
 	return &NameserverDataSource{}
@@ -23,15 +26,24 @@ type NameserverDataSource struct {
 }
 
 type NameserverDataSourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	Description types.String `tfsdk:"description"`
-	Name        types.String `tfsdk:"name"`
+	ID           types.Int64  `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	Name         types.String `tfsdk:"name"`
+	Tags         types.Set    `tfsdk:"tags"`
+	TenantID     types.Int64  `tfsdk:"tenant_id"`
+	CustomFields types.Map    `tfsdk:"custom_fields"`
 }
 
-func (m *NameserverDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.NameServer, diags diag.Diagnostics) {
+func (m *NameserverDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.NameServer, diags *diag.Diagnostics) {
 	m.ID = maybeInt64Value(resp.Id)
 	m.Description = maybeStringValue(resp.Description)
 	m.Name = maybeStringValue(&resp.Name)
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
 }
 
 func (d *NameserverDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,15 +52,21 @@ func (d *NameserverDataSource) Metadata(ctx context.Context, req datasource.Meta
 
 var nameserverDataSchema = map[string]schema.Attribute{
 	"id": schema.Int64Attribute{
-		MarkdownDescription: "ID of the resource in Netbox to use for lookup",
-		Required:            true,
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
 	},
 	"description": schema.StringAttribute{
 		Computed: true,
 	},
 	"name": schema.StringAttribute{
-		Computed: true,
+		MarkdownDescription: "Nameserver name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
 	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
 }
 
 func (d *NameserverDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -67,26 +85,65 @@ func (d *NameserverDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-
-	httpRes, err := d.client.PluginsNetboxDnsNameserversRetrieve(ctx, int(data.ID.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve nameserver: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	res, err := client.ParsePluginsNetboxDnsNameserversRetrieveResponse(httpRes)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse nameserver: %s", err))
-		return
+
+	var nameserver *client.NameServer
+	if !data.ID.IsNull() {
+		nameserver = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		nameserver = d.lookup(ctx, data, &resp.Diagnostics)
 	}
-	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, nameserver, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *NameserverDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.NameServer {
+	httpRes, err := d.client.PluginsNetboxDnsNameserversRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve nameserver: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsNameserversRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse nameserver: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookup finds the nameserver with the configured name, which must match
+// exactly one nameserver.
+func (d *NameserverDataSource) lookup(ctx context.Context, data NameserverDataSourceModel, diags *diag.Diagnostics) *client.NameServer {
+	params := client.PluginsNetboxDnsNameserversListParams{
+		Name: &[]string{data.Name.ValueString()},
+	}
+	criteria := fmt.Sprintf("named %q", data.Name.ValueString())
+	nameservers := listNameservers(ctx, d.client, params, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return singleResult(nameservers, path.Root("name"), "nameserver", "nameservers", criteria, diags)
+}
+
+func (d *NameserverDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
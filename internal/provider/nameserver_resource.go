@@ -6,24 +6,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &NameserverResource{}
 var _ resource.ResourceWithImportState = &NameserverResource{}
+var _ resource.ResourceWithModifyPlan = &NameserverResource{}
 
 func NewNameserverResource() resource.Resource {
 	return &NameserverResource{}
@@ -31,26 +31,54 @@ func NewNameserverResource() resource.Resource {
 
 // NameserverResource defines the resource implementation.
 type NameserverResource struct {
-	client *client.Client
+	client   *client.Client
+	provider *configuredProvider
 }
 
 // NameserverResourceModel describes the resource data model.
 type NameserverResourceModel struct {
-	ID          types.Int64 `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	TenantID        types.Int64  `tfsdk:"tenant_id"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+// nameserverAPIAttributes maps Netbox API fields to nameserver resource attributes.
+var nameserverAPIAttributes = map[string]string{
+	"name":          "name",
+	"description":   "description",
+	"tags":          "tags",
+	"tenant":        "tenant_id",
+	"custom_fields": "custom_fields",
 }
 
-func (m *NameserverResourceModel) ToAPIModel(ctx context.Context, diags diag.Diagnostics) client.NameServerRequest {
+func (m *NameserverResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.NameServerRequest {
 	p := client.NameServerRequest{}
 	p.Name = *m.Name.ValueStringPointer()
 	p.Description = m.Description.ValueStringPointer()
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
 	return p
 }
-func (m *NameserverResourceModel) FillFromAPIModel(ctx context.Context, resp *client.NameServer, diags diag.Diagnostics) {
-        m.ID = maybeInt64Value(resp.Id)
-        m.Name = maybeStringValue(&resp.Name)
-        m.Description = maybeStringValue(resp.Description)
+func (m *NameserverResourceModel) FillFromAPIModel(ctx context.Context, resp *client.NameServer, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
 }
 
 func (r *NameserverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -78,12 +106,46 @@ func (r *NameserverResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Nameserver description",
 				Optional:            true,
 			},
+			"tags":              tagsResourceAttribute("nameserver"),
+			"tags_all":          tagsAllResourceAttribute("nameserver"),
+			"tenant_id":         tenantIDResourceAttribute("nameserver"),
+			"custom_fields":     customFieldsResourceAttribute("nameserver"),
+			"custom_fields_all": customFieldsAllResourceAttribute("nameserver"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the nameserver in NetBox, used to detect modifications made outside of Terraform",
+			},
 		},
 	}
 }
 
 func (r *NameserverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = configureResourceClient(req, resp)
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the nameserver currently in Netbox. It returns nil without
+// error when the nameserver does not exist anymore.
+func (r *NameserverResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.NameServer {
+	httpRes, err := r.client.PluginsNetboxDnsNameserversRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve nameserver: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsNameserversRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse nameserver: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, nameserverAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
 }
 
 func (r *NameserverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -95,7 +157,7 @@ func (r *NameserverResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -111,15 +173,17 @@ func (r *NameserverResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 	if res.JSON201 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, nameserverAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON201, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.provider.forgetNames("nameserver", data.Name)
+
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a resource")
 
@@ -153,11 +217,14 @@ func (r *NameserverResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		if removeNotFound(ctx, httpRes, resp, "nameserver", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, nameserverAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -166,18 +233,50 @@ func (r *NameserverResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *NameserverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data NameserverResourceModel
+	var data, state NameserverResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpRes, err := r.client.PluginsNetboxDnsNameserversUpdate(ctx, int(data.ID.ValueInt64()), params)
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the nameserver was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The nameserver %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "nameserver", req, state.LastUpdated, remote.LastUpdated, &current, nameserverAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsNameserversUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, nameserverAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build nameserver update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsNameserversPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update nameserver: %s", err))
 		return
@@ -188,15 +287,17 @@ func (r *NameserverResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, nameserverAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.provider.forgetNames("nameserver", state.Name, data.Name)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -210,6 +311,22 @@ func (r *NameserverResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	// Check the nameserver was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "nameserver already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "nameserver", req.State, data.LastUpdated, remote.LastUpdated, &current, nameserverAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	httpRes, err := r.client.PluginsNetboxDnsNameserversDestroy(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy nameserver: %s", err))
@@ -220,10 +337,16 @@ func (r *NameserverResource) Delete(ctx context.Context, req resource.DeleteRequ
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
 		return
 	}
-	if res.StatusCode() != http.StatusNoContent {
+	if !isDeleted(ctx, res.StatusCode(), "nameserver") {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy nameserver: %s", string(res.Body)))
 		return
 	}
+	r.provider.forgetNames("nameserver", data.Name)
+}
+
+// ModifyPlan applies the provider default tags, tenant and custom fields.
+func (r *NameserverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
 }
 
 func (r *NameserverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NameserversDataSource{}
+
+func NewNameserversDataSource() datasource.DataSource {
+	return &NameserversDataSource{}
+}
+
+type NameserversDataSource struct {
+	client *client.Client
+}
+
+type NameserversDataSourceModel struct {
+	Name           types.String                `tfsdk:"name"`
+	NameContains   types.String                `tfsdk:"name_contains"`
+	NameStartsWith types.String                `tfsdk:"name_starts_with"`
+	NameNot        types.String                `tfsdk:"name_not"`
+	ZoneID         types.Int64                 `tfsdk:"zone_id"`
+	Tags           types.Set                   `tfsdk:"tags"`
+	TenantID       types.Int64                 `tfsdk:"tenant_id"`
+	Nameservers    []NameserverDataSourceModel `tfsdk:"nameservers"`
+}
+
+// ToListParams returns the Netbox list filters matching the configured filters.
+func (m *NameserversDataSourceModel) ToListParams(ctx context.Context, diags *diag.Diagnostics) client.PluginsNetboxDnsNameserversListParams {
+	params := client.PluginsNetboxDnsNameserversListParams{}
+	params.Name = stringFilter(m.Name)
+	params.NameIc = stringFilter(m.NameContains)
+	params.NameIsw = stringFilter(m.NameStartsWith)
+	params.NameN = stringFilter(m.NameNot)
+	params.ZoneId = intFilter(m.ZoneID)
+	params.Tag = tagsFilter(ctx, m.Tags, diags)
+	params.TenantId = intFilter(m.TenantID)
+	return params
+}
+
+func (d *NameserversDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nameservers"
+}
+
+func (d *NameserversDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNS Nameservers data source, returning the nameservers matching all the filters set",
+		Attributes: map[string]schema.Attribute{
+			"name":             filterAttribute("Name of the nameservers"),
+			"name_contains":    filterAttribute("Part of the name of the nameservers, case insensitive"),
+			"name_starts_with": filterAttribute("Start of the name of the nameservers, case insensitive"),
+			"name_not":         filterAttribute("Name the nameservers must not have"),
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of a zone the nameservers serve",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Slugs of tags the nameservers must all have",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tenant_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the tenant of the nameservers",
+				Optional:            true,
+			},
+			"nameservers": schema.ListNestedAttribute{
+				MarkdownDescription: "Nameservers matching the filters, with the attributes of the `netboxdns_nameserver` data source",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAttributes(nameserverDataSchema),
+				},
+			},
+		},
+	}
+}
+
+func (d *NameserversDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *NameserversDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data NameserversDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToListParams(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	nameservers := listNameservers(ctx, d.client, params, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Nameservers = make([]NameserverDataSourceModel, len(nameservers))
+	for i := range nameservers {
+		data.Nameservers[i].FillFromAPIModel(ctx, &nameservers[i], &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// partialUpdateBody builds the body of a PATCH request from the body of the
+// equivalent full update, keeping only the Netbox API fields whose attribute
+// changed between the prior state and the plan. Fields which are not modelled
+// by the resource, or not changed, are left untouched in Netbox. The fields
+// listed in always are sent whenever they are set in the full update.
+//
+// The Patched*Request client models are not used directly because some of
+// their fields are serialized even when unset, which would reset them.
+func partialUpdateBody(req resource.UpdateRequest, params interface{}, attributes map[string]string, always ...string) (io.Reader, error) {
+	fields, err := changedAPIFields(req, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	full, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(full, &values); err != nil {
+		return nil, err
+	}
+
+	patch := make(map[string]json.RawMessage, len(fields)+len(always))
+	for _, field := range always {
+		if value, ok := values[field]; ok {
+			patch[field] = value
+		}
+	}
+	for field, typ := range fields {
+		if value, ok := values[field]; ok {
+			patch[field] = value
+			continue
+		}
+		// the attribute was removed from the configuration: clear the field
+		switch {
+		case typ.Is(tftypes.String):
+			patch[field] = json.RawMessage(`""`)
+		case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+			patch[field] = json.RawMessage(`[]`)
+		default:
+			patch[field] = json.RawMessage(`null`)
+		}
+	}
+
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(body), nil
+}
+
+// changedAPIFields returns the Netbox API fields whose attribute differs
+// between the prior state and the plan, with the type of the attribute.
+// Attributes still unknown in the plan are computed by Netbox and left out.
+func changedAPIFields(req resource.UpdateRequest, attributes map[string]string) (map[string]tftypes.Type, error) {
+	return changedAttributes(req.State.Raw, req.Plan.Raw, attributes)
+}
+
+// changedAttributes returns the Netbox API fields whose attribute differs
+// between two values of the same object, skipping unknown values in to.
+func changedAttributes(from, to tftypes.Value, attributes map[string]string) (map[string]tftypes.Type, error) {
+	var fromValues, toValues map[string]tftypes.Value
+	if err := from.As(&fromValues); err != nil {
+		return nil, fmt.Errorf("failed to read prior value: %w", err)
+	}
+	if err := to.As(&toValues); err != nil {
+		return nil, fmt.Errorf("failed to read new value: %w", err)
+	}
+
+	fields := map[string]tftypes.Type{}
+	for field, attribute := range attributes {
+		if sent, ok := sentAttributes[attribute]; ok {
+			if _, ok := toValues[sent]; ok {
+				attribute = sent
+			}
+		}
+		value, ok := toValues[attribute]
+		if !ok || !value.IsKnown() {
+			continue
+		}
+		if prior, ok := fromValues[attribute]; ok && value.Equal(prior) {
+			continue
+		}
+		fields[field] = value.Type()
+	}
+	return fields, nil
+}
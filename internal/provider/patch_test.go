@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestPartialUpdateBody(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRecordResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	prior := RecordResourceModel{
+		ID:              types.Int64Value(1),
+		Name:            types.StringValue("www"),
+		ZoneID:          types.Int64Value(2),
+		Type:            types.StringValue("A"),
+		Value:           types.StringValue("192.0.2.1"),
+		Status:          types.StringValue("active"),
+		Description:     types.StringValue("web server"),
+		TTL:             types.Int64Value(300),
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+	planned := prior
+	planned.Value = types.StringValue("192.0.2.2")
+	planned.Description = types.StringNull()
+	// a provider default tag was added: the tags sent changed
+	planned.TagsAll = types.SetValueMust(types.StringType, []attr.Value{types.StringValue("production")})
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	if diags := req.Plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diags := req.State.Set(ctx, &prior); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var diags diag.Diagnostics
+	params := planned.ToAPIModel(ctx, &diags)
+	body, err := partialUpdateBody(req, params, recordAPIAttributes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := got["tags"]; !ok {
+		t.Errorf("expected tags in %v", got)
+	}
+	delete(got, "tags")
+	want := map[string]interface{}{"value": "192.0.2.2", "description": ""}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("expected %s to be %v, got %v", k, v, got[k])
+		}
+	}
+}
+
+func TestPartialUpdateBodyAlways(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewZoneResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	prior := ZoneResourceModel{
+		ID:                      types.Int64Value(1),
+		ViewID:                  types.Int64Value(1),
+		Name:                    types.StringValue("example.com"),
+		Status:                  types.StringValue("active"),
+		NameserverIDs:           types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)}),
+		NameserverNames:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ns1.example.com")}),
+		SOAMNameID:              types.Int64Value(3),
+		SOARName:                types.StringValue("hostmaster.example.com"),
+		SOASerialAuto:           types.BoolValue(true),
+		TemplateID:              types.Int64Value(1),
+		ReapplyTemplateOnUpdate: types.BoolValue(true),
+		RFC2317ChildZoneIDs:     types.SetNull(types.Int64Type),
+		Tags:                    types.SetNull(types.StringType),
+		TagsAll:                 types.SetNull(types.StringType),
+		CustomFields:            types.MapNull(types.StringType),
+		CustomFieldsAll:         types.MapNull(types.StringType),
+	}
+	planned := prior
+	planned.TemplateID = types.Int64Value(2)
+	planned.NameserverIDs = types.SetUnknown(types.Int64Type)
+	planned.SOAMNameID = types.Int64Unknown()
+
+	req := resource.UpdateRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+		State: tfsdk.State{Schema: schemaResp.Schema},
+	}
+	if diags := req.Plan.Set(ctx, &planned); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if diags := req.State.Set(ctx, &prior); diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	var diags diag.Diagnostics
+	params := planned.ToAPIModel(ctx, &diags)
+	body, err := partialUpdateBody(req, params, zoneAPIAttributes, "template", "nameservers", "soa_mname", "soa_rname")
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err := json.Unmarshal(content, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{"template": float64(2), "soa_rname": "hostmaster.example.com"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("expected %s to be %v, got %v", k, v, got[k])
+		}
+	}
+}
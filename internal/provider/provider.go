@@ -5,16 +5,20 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
+	_ "embed"
 	"fmt"
 	"net/http"
+	"time"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 	"github.com/sethvargo/go-envconfig"
 )
 
@@ -25,7 +29,6 @@ var (
 	providerDocs string
 )
 
-
 // NetboxDNSProvider defines the provider implementation.
 type NetboxDNSProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -36,18 +39,67 @@ type NetboxDNSProvider struct {
 
 // NetboxDNSProviderModel describes the provider data model.
 type NetboxDNSProviderModel struct {
-	ServerURL          types.String `tfsdk:"server_url"`
-	APIToken           types.String `tfsdk:"api_token"`
-	AllowInsecureHTTPS types.Bool   `tfsdk:"allow_insecure_https"`
-	Headers            types.Map    `tfsdk:"headers"`
-	RequestTimeout     types.Int64  `tfsdk:"request_timeout"`
+	ServerURL          types.String  `tfsdk:"server_url"`
+	APIToken           types.String  `tfsdk:"api_token"`
+	AllowInsecureHTTPS types.Bool    `tfsdk:"allow_insecure_https"`
+	Headers            types.Map     `tfsdk:"headers"`
+	RequestTimeout     types.Int64   `tfsdk:"request_timeout"`
+	CACertFile         types.String  `tfsdk:"ca_cert_file"`
+	CACert             types.String  `tfsdk:"ca_cert"`
+	ClientCertFile     types.String  `tfsdk:"client_cert_file"`
+	ClientKeyFile      types.String  `tfsdk:"client_key_file"`
+	ClientCert         types.String  `tfsdk:"client_cert"`
+	ClientKey          types.String  `tfsdk:"client_key"`
+	ProxyURL           types.String  `tfsdk:"proxy_url"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin       types.Int64   `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.Int64   `tfsdk:"retry_wait_max"`
+	RequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	ConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	FullUpdates        types.Bool    `tfsdk:"full_updates"`
+	OnConflict         types.String  `tfsdk:"on_conflict"`
+
+	DefaultTags         *ProviderDefaultTagsModel         `tfsdk:"default_tags"`
+	DefaultTenant       *ProviderDefaultTenantModel       `tfsdk:"default_tenant"`
+	DefaultCustomFields *ProviderDefaultCustomFieldsModel `tfsdk:"default_custom_fields"`
+}
+
+// ProviderDefaultTagsModel describes the default_tags block of the provider.
+type ProviderDefaultTagsModel struct {
+	Tags types.Set `tfsdk:"tags"`
+}
+
+// ProviderDefaultTenantModel describes the default_tenant block of the provider.
+type ProviderDefaultTenantModel struct {
+	TenantID types.Int64 `tfsdk:"tenant_id"`
+}
+
+// ProviderDefaultCustomFieldsModel describes the default_custom_fields block
+// of the provider.
+type ProviderDefaultCustomFieldsModel struct {
+	CustomFields types.Map `tfsdk:"custom_fields"`
 }
 
 type NetboxDNSProviderEnvModel struct {
-	ServerURL          string `env:"NETBOX_SERVER_URL"`
-	APIToken           string `env:"NETBOX_API_TOKEN"`
-	AllowInsecureHTTPS *bool  `env:"NETBOX_ALLOW_INSECURE_HTTPS"`
-	RequestTimeout     int64  `env:"NETBOX_REQUEST_TIMEOUT"`
+	ServerURL          string            `env:"NETBOX_SERVER_URL"`
+	APIToken           string            `env:"NETBOX_API_TOKEN"`
+	AllowInsecureHTTPS *bool             `env:"NETBOX_ALLOW_INSECURE_HTTPS"`
+	Headers            map[string]string `env:"NETBOX_HEADERS"`
+	RequestTimeout     int64             `env:"NETBOX_REQUEST_TIMEOUT"`
+	CACertFile         string            `env:"NETBOX_CA_CERT_FILE"`
+	CACert             string            `env:"NETBOX_CA_CERT"`
+	ClientCertFile     string            `env:"NETBOX_CLIENT_CERT_FILE"`
+	ClientKeyFile      string            `env:"NETBOX_CLIENT_KEY_FILE"`
+	ClientCert         string            `env:"NETBOX_CLIENT_CERT"`
+	ClientKey          string            `env:"NETBOX_CLIENT_KEY"`
+	ProxyURL           string            `env:"NETBOX_PROXY_URL"`
+	MaxRetries         *int64            `env:"NETBOX_MAX_RETRIES"`
+	RetryWaitMin       int64             `env:"NETBOX_RETRY_WAIT_MIN"`
+	RetryWaitMax       int64             `env:"NETBOX_RETRY_WAIT_MAX"`
+	RequestsPerSecond  float64           `env:"NETBOX_MAX_REQUESTS_PER_SECOND"`
+	ConcurrentRequests int64             `env:"NETBOX_MAX_CONCURRENT_REQUESTS"`
+	FullUpdates        *bool             `env:"NETBOX_FULL_UPDATES"`
+	OnConflict         string            `env:"NETBOX_ON_CONFLICT"`
 }
 
 func (p *NetboxDNSProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -72,14 +124,105 @@ func (p *NetboxDNSProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:            true,
 			},
 			"headers": schema.MapAttribute{
-				MarkdownDescription: "Set these header on all requests to Netbox. Can be set via the `NETBOX_HEADERS` environment variable.",
+				MarkdownDescription: "Set these header on all requests to Netbox. Can be set via the `NETBOX_HEADERS` environment variable, formatted as `Name1:value1,Name2:value2`.",
 				ElementType:         types.StringType,
 				Optional:            true,
 			},
 			"request_timeout": schema.Int64Attribute{
-				MarkdownDescription: "Netbox API HTTP request timeout in seconds. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable.",
+				MarkdownDescription: "Netbox API HTTP request timeout in seconds. Can be set via the `NETBOX_REQUEST_TIMEOUT` environment variable. Defaults to `10`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle used to verify the Netbox server certificate, in addition to the system CAs. Conflicts with `ca_cert`. Can be set via the `NETBOX_CA_CERT_FILE` environment variable.",
 				Optional:            true,
 			},
+			"ca_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle used to verify the Netbox server certificate, in addition to the system CAs. Conflicts with `ca_cert_file`. Can be set via the `NETBOX_CA_CERT` environment variable.",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded client certificate for mutual TLS authentication. Conflicts with `client_cert`. Can be set via the `NETBOX_CLIENT_CERT_FILE` environment variable.",
+				Optional:            true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM encoded private key of the client certificate. Conflicts with `client_key`. Can be set via the `NETBOX_CLIENT_KEY_FILE` environment variable.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS authentication. Conflicts with `client_cert_file`. Can be set via the `NETBOX_CLIENT_CERT` environment variable.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of the client certificate. Conflicts with `client_key_file`. Can be set via the `NETBOX_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP or HTTPS proxy used to reach Netbox. Defaults to the proxy set by the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables. Can be set via the `NETBOX_PROXY_URL` environment variable.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries of a Netbox API request failing with a transient error (connection error, rate limiting, unavailable server or database lock). Requests creating objects are only retried when Netbox cannot have processed them. Set to `0` to disable retries. Can be set via the `NETBOX_MAX_RETRIES` environment variable. Defaults to `3`.",
+				Optional:            true,
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait before retrying a request. The wait time doubles with each retry. Can be set via the `NETBOX_RETRY_WAIT_MIN` environment variable. Defaults to `1`.",
+				Optional:            true,
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait before retrying a request, including delays requested by Netbox through the `Retry-After` header. Can be set via the `NETBOX_RETRY_WAIT_MAX` environment variable. Defaults to `30`.",
+				Optional:            true,
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to Netbox by all resources and data sources of the provider, retries included. Can be set via the `NETBOX_MAX_REQUESTS_PER_SECOND` environment variable. Defaults to no limit.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests sent to Netbox in parallel by all resources and data sources of the provider. Can be set via the `NETBOX_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to no limit.",
+				Optional:            true,
+			},
+			"full_updates": schema.BoolAttribute{
+				MarkdownDescription: "Flag to send the whole object when updating a resource (PUT), instead of only the changed attributes (PATCH). Full updates reset the fields of the object not managed by Terraform. Can be set via the `NETBOX_FULL_UPDATES` environment variable. Defaults to `false`.",
+				Optional:            true,
+			},
+			"on_conflict": schema.StringAttribute{
+				MarkdownDescription: "Behavior when an object was modified in Netbox since Terraform last read it, detected before updating or deleting it by comparing its `last_updated` timestamp. With `fail`, the update or delete is refused when an attribute managed by Terraform was modified, the modifications of other fields, like the SOA serial NetBox increments when the records of a zone change, being kept. With `merge`, only the updates overwriting a modified attribute with a different value are refused, the modifications of fields not managed by Terraform are kept, and deletes proceed. Can be set via the `NETBOX_ON_CONFLICT` environment variable. Defaults to `fail`.",
+				Optional:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				MarkdownDescription: "Tags added to all the objects created or updated by the resources of the provider. The tags of all the objects are then managed by Terraform, and their effective value is reported in the `tags_all` attribute of the resources.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.SetAttribute{
+						MarkdownDescription: "Slugs of the tags",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"default_tenant": schema.SingleNestedBlock{
+				MarkdownDescription: "Tenant of the objects created or updated by the resources of the provider whose `tenant_id` is not set.",
+				Attributes: map[string]schema.Attribute{
+					"tenant_id": schema.Int64Attribute{
+						MarkdownDescription: "ID of the tenant",
+						Optional:            true,
+					},
+				},
+			},
+			"default_custom_fields": schema.SingleNestedBlock{
+				MarkdownDescription: "Custom fields set on all the objects created or updated by the resources of the provider, unless overridden by their `custom_fields` attribute. Their effective value is reported in the `custom_fields_all` attribute of the resources.",
+				Attributes: map[string]schema.Attribute{
+					"custom_fields": schema.MapAttribute{
+						MarkdownDescription: "Custom fields, with their values encoded in JSON",
+						ElementType:         types.StringType,
+						Optional:            true,
+						Validators: []validator.Map{
+							mapvalidator.ValueStringsAre(jsonValidator{}),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -93,6 +236,23 @@ func apiKeyAuth(token string) client.RequestEditorFn {
 
 type configuredProvider struct {
 	Client *client.Client
+	// FullUpdates makes resources send the whole object on update (PUT)
+	// instead of only the changed fields (PATCH)
+	FullUpdates bool
+	// OnConflict is the behavior when an object was modified in Netbox
+	// since it was last read, either conflictFail or conflictMerge
+	OnConflict string
+	// DefaultTags are the slugs of the tags added to the objects of all
+	// resources
+	DefaultTags []string
+	// DefaultTenantID is the tenant of the objects of the resources whose
+	// tenant is not set, nil when there is none
+	DefaultTenantID *int64
+	// DefaultCustomFields are the custom fields set on the objects of all
+	// resources, with their values encoded in JSON
+	DefaultCustomFields map[string]string
+	// names caches the IDs of the objects configured by name
+	names *nameCache
 }
 
 func (p *NetboxDNSProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
@@ -122,14 +282,73 @@ func (p *NetboxDNSProvider) Configure(ctx context.Context, req provider.Configur
 	if data.AllowInsecureHTTPS.IsNull() && envData.AllowInsecureHTTPS != nil {
 		data.AllowInsecureHTTPS = types.BoolValue(*envData.AllowInsecureHTTPS)
 	}
+	if data.Headers.IsNull() && len(envData.Headers) > 0 {
+		headers, diags := types.MapValueFrom(ctx, types.StringType, envData.Headers)
+		resp.Diagnostics.Append(diags...)
+		data.Headers = headers
+	}
 	if data.RequestTimeout.IsNull() && envData.RequestTimeout > 0 {
 		data.RequestTimeout = types.Int64Value(envData.RequestTimeout)
 	}
+	if data.CACertFile.IsNull() && envData.CACertFile != "" {
+		data.CACertFile = types.StringValue(envData.CACertFile)
+	}
+	if data.CACert.IsNull() && envData.CACert != "" {
+		data.CACert = types.StringValue(envData.CACert)
+	}
+	if data.ClientCertFile.IsNull() && envData.ClientCertFile != "" {
+		data.ClientCertFile = types.StringValue(envData.ClientCertFile)
+	}
+	if data.ClientKeyFile.IsNull() && envData.ClientKeyFile != "" {
+		data.ClientKeyFile = types.StringValue(envData.ClientKeyFile)
+	}
+	if data.ClientCert.IsNull() && envData.ClientCert != "" {
+		data.ClientCert = types.StringValue(envData.ClientCert)
+	}
+	if data.ClientKey.IsNull() && envData.ClientKey != "" {
+		data.ClientKey = types.StringValue(envData.ClientKey)
+	}
+	if data.ProxyURL.IsNull() && envData.ProxyURL != "" {
+		data.ProxyURL = types.StringValue(envData.ProxyURL)
+	}
+	if data.MaxRetries.IsNull() && envData.MaxRetries != nil {
+		data.MaxRetries = types.Int64Value(*envData.MaxRetries)
+	}
+	if data.RetryWaitMin.IsNull() && envData.RetryWaitMin > 0 {
+		data.RetryWaitMin = types.Int64Value(envData.RetryWaitMin)
+	}
+	if data.RetryWaitMax.IsNull() && envData.RetryWaitMax > 0 {
+		data.RetryWaitMax = types.Int64Value(envData.RetryWaitMax)
+	}
+	if data.RequestsPerSecond.IsNull() && envData.RequestsPerSecond > 0 {
+		data.RequestsPerSecond = types.Float64Value(envData.RequestsPerSecond)
+	}
+	if data.ConcurrentRequests.IsNull() && envData.ConcurrentRequests > 0 {
+		data.ConcurrentRequests = types.Int64Value(envData.ConcurrentRequests)
+	}
+	if data.FullUpdates.IsNull() && envData.FullUpdates != nil {
+		data.FullUpdates = types.BoolValue(*envData.FullUpdates)
+	}
+	if data.OnConflict.IsNull() && envData.OnConflict != "" {
+		data.OnConflict = types.StringValue(envData.OnConflict)
+	}
 
 	// apply defaults
 	if data.RequestTimeout.IsNull() {
 		data.RequestTimeout = types.Int64Value(10)
 	}
+	if data.MaxRetries.IsNull() {
+		data.MaxRetries = types.Int64Value(3)
+	}
+	if data.RetryWaitMin.IsNull() {
+		data.RetryWaitMin = types.Int64Value(1)
+	}
+	if data.RetryWaitMax.IsNull() {
+		data.RetryWaitMax = types.Int64Value(30)
+	}
+	if data.OnConflict.IsNull() {
+		data.OnConflict = types.StringValue(conflictFail)
+	}
 
 	if data.ServerURL.IsNull() {
 		resp.Diagnostics.AddError("Missing required attribute", "Server URL is required")
@@ -137,22 +356,62 @@ func (p *NetboxDNSProvider) Configure(ctx context.Context, req provider.Configur
 	if data.APIToken.IsNull() {
 		resp.Diagnostics.AddError("Missing required attribute", "API token is required")
 	}
+	if data.RequestTimeout.ValueInt64() <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid attribute value", "Request timeout must be a positive number of seconds")
+	}
+	if data.MaxRetries.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid attribute value", "Maximum number of retries can't be negative")
+	}
+	if data.RetryWaitMin.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_wait_min"), "Invalid attribute value", "Minimum retry wait time can't be negative")
+	}
+	if data.RetryWaitMax.ValueInt64() < data.RetryWaitMin.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_wait_max"), "Invalid attribute value", "Maximum retry wait time must be greater than or equal to the minimum retry wait time")
+	}
+	if data.RequestsPerSecond.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_requests_per_second"), "Invalid attribute value", "Maximum number of requests per second can't be negative")
+	}
+	if data.ConcurrentRequests.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid attribute value", "Maximum number of concurrent requests can't be negative")
+	}
+	if mode := data.OnConflict.ValueString(); mode != conflictFail && mode != conflictMerge {
+		resp.Diagnostics.AddAttributeError(path.Root("on_conflict"), "Invalid attribute value", fmt.Sprintf("Conflict behavior must be %q or %q, got %q", conflictFail, conflictMerge, mode))
+	}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	opts := []client.ClientOption{
-		client.WithRequestEditorFn(apiKeyAuth(data.APIToken.ValueString())), // auth
+	transport := transportConfig{
+		AllowInsecureHTTPS: data.AllowInsecureHTTPS.ValueBool(),
+		RequestTimeout:     time.Duration(data.RequestTimeout.ValueInt64()) * time.Second,
+		CACertFile:         data.CACertFile.ValueString(),
+		CACertPEM:          data.CACert.ValueString(),
+		ClientCertFile:     data.ClientCertFile.ValueString(),
+		ClientKeyFile:      data.ClientKeyFile.ValueString(),
+		ClientCertPEM:      data.ClientCert.ValueString(),
+		ClientKeyPEM:       data.ClientKey.ValueString(),
+		ProxyURL:           data.ProxyURL.ValueString(),
+		MaxRetries:         int(data.MaxRetries.ValueInt64()),
+		RetryWaitMin:       time.Duration(data.RetryWaitMin.ValueInt64()) * time.Second,
+		RetryWaitMax:       time.Duration(data.RetryWaitMax.ValueInt64()) * time.Second,
+		RequestsPerSecond:  data.RequestsPerSecond.ValueFloat64(),
+		ConcurrentRequests: int(data.ConcurrentRequests.ValueInt64()),
 	}
-	if !data.AllowInsecureHTTPS.IsNull() && data.AllowInsecureHTTPS.ValueBool() {
-		httpClient := &http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: true,
-				},
-			},
+	if !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &transport.Headers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		opts = append(opts, client.WithHTTPClient(httpClient))
+	}
+	httpClient, err := newHTTPClient(transport)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to configure HTTP client", err.Error())
+		return
+	}
+
+	opts := []client.ClientOption{
+		client.WithRequestEditorFn(apiKeyAuth(data.APIToken.ValueString())), // auth
+		client.WithHTTPClient(httpClient),
 	}
 
 	client, err := client.NewClient(data.ServerURL.ValueString(), opts...)
@@ -162,7 +421,22 @@ func (p *NetboxDNSProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	providerData := configuredProvider{
-		Client: client,
+		Client:      client,
+		FullUpdates: data.FullUpdates.ValueBool(),
+		OnConflict:  data.OnConflict.ValueString(),
+		names:       newNameCache(),
+	}
+	if data.DefaultTags != nil && !data.DefaultTags.Tags.IsNull() {
+		resp.Diagnostics.Append(data.DefaultTags.Tags.ElementsAs(ctx, &providerData.DefaultTags, false)...)
+	}
+	if data.DefaultTenant != nil {
+		providerData.DefaultTenantID = data.DefaultTenant.TenantID.ValueInt64Pointer()
+	}
+	if data.DefaultCustomFields != nil && !data.DefaultCustomFields.CustomFields.IsNull() {
+		resp.Diagnostics.Append(data.DefaultCustomFields.CustomFields.ElementsAs(ctx, &providerData.DefaultCustomFields, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
 	}
 	resp.DataSourceData = &providerData
 	resp.ResourceData = &providerData
@@ -171,12 +445,34 @@ func (p *NetboxDNSProvider) Configure(ctx context.Context, req provider.Configur
 func (p *NetboxDNSProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewRecordResource,
+		NewZoneResource,
+		NewViewResource,
+		NewNameserverResource,
+		NewDNSSECPolicyResource,
+		NewDNSSECKeyTemplateResource,
+		NewZoneTemplateResource,
+		NewRecordTemplateResource,
+		NewRegistrarResource,
+		NewRegistrationContactResource,
 	}
 }
 
 func (p *NetboxDNSProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewRecordDataSource,
+		NewRecordsDataSource,
+		NewZoneDataSource,
+		NewZonesDataSource,
+		NewViewDataSource,
+		NewViewsDataSource,
+		NewNameserverDataSource,
+		NewNameserversDataSource,
+		NewDNSSECPolicyDataSource,
+		NewDNSSECKeyTemplateDataSource,
+		NewZoneTemplateDataSource,
+		NewRecordTemplateDataSource,
+		NewRegistrarDataSource,
+		NewRegistrationContactDataSource,
 	}
 }
 
@@ -188,7 +484,7 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-func configureResourceClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *client.Client {
+func configureResourceProvider(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *configuredProvider {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return nil
@@ -203,7 +499,7 @@ func configureResourceClient(req resource.ConfigureRequest, resp *resource.Confi
 		return nil
 	}
 
-	return data.Client
+	return data
 }
 
 func configureDataSourceClient(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *client.Client {
@@ -3,16 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &RecordDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &RecordDataSource{}
 
 func NewRecordDataSource() datasource.DataSource {
 	return &RecordDataSource{}
@@ -23,25 +29,56 @@ type RecordDataSource struct {
 }
 
 type RecordDataSourceModel struct {
-	ID          types.Int64  `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Zone        *NestedZone  `tfsdk:"zone"`
-	Type        types.String `tfsdk:"type"`
-	Value       types.String `tfsdk:"value"`
-	Status      types.String `tfsdk:"status"`
-	Description types.String `tfsdk:"description"`
-	TTL         types.Int64 `tfsdk:"ttl"`
+	ID            types.Int64   `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	FQDN          types.String  `tfsdk:"fqdn"`
+	Zone          *NestedZone   `tfsdk:"zone"`
+	ZoneName      types.String  `tfsdk:"zone_name"`
+	Type          types.String  `tfsdk:"type"`
+	Value         types.String  `tfsdk:"value"`
+	AbsoluteValue types.String  `tfsdk:"absolute_value"`
+	Active        types.Bool    `tfsdk:"active"`
+	Managed       types.Bool    `tfsdk:"managed"`
+	Status        types.String  `tfsdk:"status"`
+	Description   types.String  `tfsdk:"description"`
+	TTL           types.Int64   `tfsdk:"ttl"`
+	DisablePtr    types.Bool    `tfsdk:"disable_ptr"`
+	PtrRecord     *NestedRecord `tfsdk:"ptr_record"`
+	AddressRecord *NestedRecord `tfsdk:"address_record"`
+	Tags          types.Set     `tfsdk:"tags"`
+	TenantID      types.Int64   `tfsdk:"tenant_id"`
+	CustomFields  types.Map     `tfsdk:"custom_fields"`
 }
 
-func (m *RecordDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.Record, diags diag.Diagnostics) {
+func (m *RecordDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.Record, diags *diag.Diagnostics) {
 	m.ID = maybeInt64Value(resp.Id)
 	m.Name = maybeStringValue(&(resp.Name))
+	// keep the FQDN configured without the trailing dot
+	if fqdn := maybeStringValue(resp.Fqdn); m.FQDN.IsNull() || m.FQDN.IsUnknown() || strings.TrimSuffix(m.FQDN.ValueString(), ".") != strings.TrimSuffix(fqdn.ValueString(), ".") {
+		m.FQDN = fqdn
+	}
 	m.Zone = NestedZoneFromAPI(resp.Zone)
+	m.ZoneName = types.StringNull()
+	if resp.Zone != nil {
+		m.ZoneName = types.StringValue(resp.Zone.Name)
+	}
 	m.Type = maybeStringValue((*string)(&resp.Type))
 	m.Value = maybeStringValue(&resp.Value)
+	m.AbsoluteValue = maybeStringValue(resp.AbsoluteValue)
+	m.Active = maybeBoolValue(resp.Active)
+	m.Managed = maybeBoolValue(resp.Managed)
 	m.Status = maybeStringValue((*string)(resp.Status))
 	m.Description = maybeStringValue(resp.Description)
 	m.TTL = maybeInt64Value(resp.Ttl)
+	m.DisablePtr = maybeBoolValue(resp.DisablePtr)
+	m.PtrRecord = NestedRecordFromAPI(resp.PtrRecord)
+	m.AddressRecord = NestedRecordFromAPI(resp.AddressRecord)
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
 }
 
 func (d *RecordDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -50,22 +87,61 @@ func (d *RecordDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 var recordDataSchema = map[string]schema.Attribute{
 	"id": schema.Int64Attribute{
-		MarkdownDescription: "ID of the resource in Netbox to use for lookup",
-		Required:            true,
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `fqdn` and `name`.",
+		Optional:            true,
+		Computed:            true,
 	},
 	"name": schema.StringAttribute{
-		Computed: true,
+		MarkdownDescription: "Record name to use for lookup, with `zone_name` and `type`. Conflicts with `id` and `fqdn`.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(
+				path.MatchRoot("zone_name"),
+				path.MatchRoot("type"),
+			),
+		},
+	},
+	"fqdn": schema.StringAttribute{
+		MarkdownDescription: "Fully qualified domain name of the record to use for lookup, optionally with `type`. Conflicts with `id` and `name`.",
+		Optional:            true,
+		Computed:            true,
 	},
 	"zone": schema.SingleNestedAttribute{
 		Computed:   true,
 		Attributes: (*NestedZone)(nil).SchemaAttributes(),
 	},
+	"zone_name": schema.StringAttribute{
+		MarkdownDescription: "Name of the zone of the record to use for lookup with `name`",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("name")),
+		},
+	},
 	"type": schema.StringAttribute{
-		Computed: true,
+		MarkdownDescription: "DNS Record type (A, CNAME, etc.) to use for lookup with `fqdn` or `name`",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("id")),
+		},
 	},
 	"value": schema.StringAttribute{
 		Computed: true,
 	},
+	"absolute_value": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Value of the record with the names it contains made absolute",
+	},
+	"active": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "True if both the record and its zone are active",
+	},
+	"managed": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "True if the record was generated by NetBox",
+	},
 	"status": schema.StringAttribute{
 		Computed:            true,
 		MarkdownDescription: `"active" or "inactive"`,
@@ -73,12 +149,26 @@ var recordDataSchema = map[string]schema.Attribute{
 	"description": schema.StringAttribute{
 		Computed: true,
 	},
-	"comments": schema.StringAttribute{
-		Computed: true,
-	},
 	"ttl": schema.Int64Attribute{
 		Computed: true,
 	},
+	"disable_ptr": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "True if NetBox does not create the PTR record of the A or AAAA record",
+	},
+	"ptr_record": schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "PTR record created by NetBox for the A or AAAA record",
+		Attributes:          (*NestedRecord)(nil).SchemaAttributes(),
+	},
+	"address_record": schema.SingleNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: "A or AAAA record the PTR record was created by NetBox for",
+		Attributes:          (*NestedRecord)(nil).SchemaAttributes(),
+	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
 }
 
 func (d *RecordDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -97,26 +187,80 @@ func (d *RecordDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-
-	httpRes, err := d.client.PluginsNetboxDnsRecordsRetrieve(ctx, int(data.ID.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve record: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	res, err := client.ParsePluginsNetboxDnsRecordsRetrieveResponse(httpRes)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse record: %s", err))
-		return
+
+	var record *client.Record
+	if !data.ID.IsNull() {
+		record = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		record = d.lookup(ctx, data, &resp.Diagnostics)
 	}
-	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, record, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *RecordDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.Record {
+	httpRes, err := d.client.PluginsNetboxDnsRecordsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve record: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse record: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookup finds the record with the configured FQDN, or name and zone, and
+// type, which must match exactly one record.
+func (d *RecordDataSource) lookup(ctx context.Context, data RecordDataSourceModel, diags *diag.Diagnostics) *client.Record {
+	params := client.PluginsNetboxDnsRecordsListParams{}
+	attribute := path.Root("name")
+	var criteria string
+	if fqdn := fromStringValue(data.FQDN); fqdn != nil {
+		// Netbox stores absolute names
+		absolute := strings.TrimSuffix(*fqdn, ".") + "."
+		params.Fqdn = &[]string{absolute}
+		attribute = path.Root("fqdn")
+		criteria = fmt.Sprintf("with FQDN %q", absolute)
+	} else {
+		params.Name = &[]string{data.Name.ValueString()}
+		params.Zone = &[]string{data.ZoneName.ValueString()}
+		criteria = fmt.Sprintf("named %q in zone %q", data.Name.ValueString(), data.ZoneName.ValueString())
+	}
+	if recordType := fromStringValue(data.Type); recordType != nil {
+		params.Type = &[]string{*recordType}
+		criteria = fmt.Sprintf("of type %s %s", *recordType, criteria)
+	}
+	records := listRecords(ctx, d.client, params, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return singleResult(records, attribute, "record", "records", criteria, diags)
+}
+
+func (d *RecordDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("fqdn"),
+			path.MatchRoot("name"),
+		),
+	}
+}
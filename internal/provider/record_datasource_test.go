@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestRecordDataSourceLookup(t *testing.T) {
+	var query string
+	count := 1
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		results := ""
+		for i := 1; i <= count; i++ {
+			if i > 1 {
+				results += ", "
+			}
+			results += fmt.Sprintf(`{"id": %d, "name": "www", "type": "A", "value": "192.0.2.%d"}`, i, i)
+		}
+		fmt.Fprintf(w, `{"count": %d, "next": null, "results": [%s]}`, count, results)
+	}))
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &RecordDataSource{client: c}
+
+	tests := map[string]struct {
+		data      RecordDataSourceModel
+		count     int
+		wantQuery string
+		wantError bool
+	}{
+		"fqdn": {
+			data:      RecordDataSourceModel{FQDN: types.StringValue("www.example.com"), Type: types.StringValue("A")},
+			count:     1,
+			wantQuery: "fqdn=www.example.com.&limit=1000&offset=0&type=A",
+		},
+		"name and zone": {
+			data:      RecordDataSourceModel{Name: types.StringValue("www"), ZoneName: types.StringValue("example.com"), Type: types.StringValue("A")},
+			count:     1,
+			wantQuery: "limit=1000&name=www&offset=0&type=A&zone=example.com",
+		},
+		"not found": {
+			data:      RecordDataSourceModel{FQDN: types.StringValue("www.example.com.")},
+			count:     0,
+			wantQuery: "fqdn=www.example.com.&limit=1000&offset=0",
+			wantError: true,
+		},
+		"several records": {
+			data:      RecordDataSourceModel{FQDN: types.StringValue("www.example.com.")},
+			count:     2,
+			wantQuery: "fqdn=www.example.com.&limit=1000&offset=0",
+			wantError: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			count = test.count
+			var diags diag.Diagnostics
+			record := d.lookup(context.Background(), test.data, &diags)
+			if query != test.wantQuery {
+				t.Errorf("expected query %s, got %s", test.wantQuery, query)
+			}
+			if diags.HasError() != test.wantError {
+				t.Errorf("expected error %t, got %s", test.wantError, diags)
+			}
+			if !test.wantError && (record == nil || *record.Id != 1) {
+				t.Errorf("expected record 1, got %v", record)
+			}
+		})
+	}
+}
@@ -6,26 +6,32 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RecordResource{}
 var _ resource.ResourceWithImportState = &RecordResource{}
+var _ resource.ResourceWithModifyPlan = &RecordResource{}
 
 func NewRecordResource() resource.Resource {
 	return &RecordResource{}
@@ -33,50 +39,128 @@ func NewRecordResource() resource.Resource {
 
 // RecordResource defines the resource implementation.
 type RecordResource struct {
-	client *client.Client
+	client   *client.Client
+	provider *configuredProvider
 }
 
 // RecordResourceModel describes the resource data model.
 type RecordResourceModel struct {
-	ID          types.Int64 `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	ZoneID      types.Int64 `tfsdk:"zone_id"`
-	Type        types.String `tfsdk:"type"`
-	Value       types.String `tfsdk:"value"`
-	Status      types.String `tfsdk:"status"`
-	Description types.String `tfsdk:"description"`
-	TTL         types.Int64 `tfsdk:"ttl"`
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	ZoneID          types.Int64  `tfsdk:"zone_id"`
+	ZoneName        types.String `tfsdk:"zone_name"`
+	ViewName        types.String `tfsdk:"view_name"`
+	Type            types.String `tfsdk:"type"`
+	Value           types.String `tfsdk:"value"`
+	Status          types.String `tfsdk:"status"`
+	Description     types.String `tfsdk:"description"`
+	TTL             types.Int64  `tfsdk:"ttl"`
+	DisablePtr      types.Bool   `tfsdk:"disable_ptr"`
+	PtrRecordID     types.Int64  `tfsdk:"ptr_record_id"`
+	PtrRecordFQDN   types.String `tfsdk:"ptr_record_fqdn"`
+	PtrRecordZoneID types.Int64  `tfsdk:"ptr_record_zone_id"`
+	AddressRecordID types.Int64  `tfsdk:"address_record_id"`
+	FQDN            types.String `tfsdk:"fqdn"`
+	AbsoluteValue   types.String `tfsdk:"absolute_value"`
+	Active          types.Bool   `tfsdk:"active"`
+	Managed         types.Bool   `tfsdk:"managed"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	TenantID        types.Int64  `tfsdk:"tenant_id"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
 }
 
-func (m *RecordResourceModel) ToAPIModel(ctx context.Context, diags diag.Diagnostics) client.WritableRecordRequest {
+// recordAPIAttributes maps Netbox API fields to record resource attributes.
+var recordAPIAttributes = map[string]string{
+	"name":          "name",
+	"zone":          "zone_id",
+	"type":          "type",
+	"value":         "value",
+	"status":        "status",
+	"description":   "description",
+	"ttl":           "ttl",
+	"disable_ptr":   "disable_ptr",
+	"tags":          "tags",
+	"tenant":        "tenant_id",
+	"custom_fields": "custom_fields",
+}
+
+func (m *RecordResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.WritableRecordRequest {
 	p := client.WritableRecordRequest{}
 
 	p.Name = m.Name.ValueString()
-	p.Zone = *fromInt64Value(m.ZoneID)
+	if zone := fromInt64Value(m.ZoneID); zone != nil {
+		p.Zone = *zone
+	} else {
+		diags.AddAttributeError(path.Root("zone_id"), "Unknown Zone", "The zone of the record is not known: set zone_id, or zone_name to an existing zone.")
+	}
 
 	recordtype := client.WritableRecordRequestType(m.Type.ValueString())
 	p.Type = recordtype
 
 	p.Value = m.Value.ValueString()
-	if !m.Status.IsNull() {
+	if !m.Status.IsNull() && !m.Status.IsUnknown() {
 		recordstatus := client.WritableRecordRequestStatus(m.Status.ValueString())
 		p.Status = &recordstatus
 	}
 	p.Description = m.Description.ValueStringPointer()
 	p.Ttl = fromInt64Value(m.TTL)
-	
+	p.DisablePtr = fromBoolValue(m.DisablePtr)
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
+
 	return p
 }
 
-func (m *RecordResourceModel) FillFromAPIModel(ctx context.Context, resp *client.Record, diags diag.Diagnostics) {
-        m.ID = maybeInt64Value(resp.Id)
-        m.Name = maybeStringValue(&resp.Name)
-	m.ZoneID = maybeInt64Value(resp.Zone.Id)
+func (m *RecordResourceModel) FillFromAPIModel(ctx context.Context, resp *client.Record, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.ZoneID = types.Int64Null()
+	m.ZoneName = types.StringNull()
+	m.ViewName = types.StringNull()
+	if resp.Zone != nil {
+		m.ZoneID = maybeInt64Value(resp.Zone.Id)
+		m.ZoneName = types.StringValue(resp.Zone.Name)
+		if resp.Zone.View != nil {
+			m.ViewName = types.StringValue(resp.Zone.View.Name)
+		}
+	}
 	m.Type = maybeStringValue((*string)(&resp.Type))
-        m.Value = maybeStringValue(&resp.Value)
+	m.Value = maybeStringValue(&resp.Value)
 	m.Status = maybeStringValue((*string)(resp.Status))
-        m.Description = maybeStringValue(resp.Description)
+	m.Description = maybeStringValue(resp.Description)
 	m.TTL = maybeInt64Value(resp.Ttl)
+	m.DisablePtr = maybeBoolValue(resp.DisablePtr)
+	m.PtrRecordID = types.Int64Null()
+	m.PtrRecordFQDN = types.StringNull()
+	m.PtrRecordZoneID = types.Int64Null()
+	if resp.PtrRecord != nil {
+		m.PtrRecordID = maybeInt64Value(resp.PtrRecord.Id)
+		m.PtrRecordFQDN = nestedRecordFQDN(resp.PtrRecord)
+		if resp.PtrRecord.Zone != nil {
+			m.PtrRecordZoneID = maybeInt64Value(resp.PtrRecord.Zone.Id)
+		}
+	}
+	m.AddressRecordID = types.Int64Null()
+	if resp.AddressRecord != nil {
+		m.AddressRecordID = maybeInt64Value(resp.AddressRecord.Id)
+	}
+	m.FQDN = maybeStringValue(resp.Fqdn)
+	m.AbsoluteValue = maybeStringValue(resp.AbsoluteValue)
+	m.Active = maybeBoolValue(resp.Active)
+	m.Managed = maybeBoolValue(resp.Managed)
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
 }
 
 func (r *RecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -101,8 +185,34 @@ func (r *RecordResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 			},
 			"zone_id": schema.Int64Attribute{
-				MarkdownDescription: "DNS Zone id",
-				Required:            true,
+				MarkdownDescription: "DNS Zone id. Exactly one of `zone_id` and `zone_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("zone_name")),
+				},
+			},
+			"zone_name": schema.StringAttribute{
+				MarkdownDescription: "DNS Zone name. Exactly one of `zone_id` and `zone_name` must be set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"view_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the DNS View of the zone, to find the zone set by `zone_name` when zones with the same name exist in several views",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("zone_id")),
+				},
 			},
 			"type": schema.StringAttribute{
 				MarkdownDescription: "DNS Record type (A, CNAME, etc.)",
@@ -113,8 +223,10 @@ func (r *RecordResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:            true,
 			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "Record status (active or inactive)",
+				MarkdownDescription: "Record status (active or inactive). Defaults to active.",
 				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(client.RecordStatusActive)),
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						string(client.RecordStatusActive),
@@ -126,17 +238,91 @@ func (r *RecordResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "Record description",
 				Optional:            true,
 			},
+			"disable_ptr": schema.BoolAttribute{
+				MarkdownDescription: "Do not create the PTR record of an A or AAAA record in the matching reverse zone. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ptr_record_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the PTR record created by NetBox for an A or AAAA record",
+			},
+			"ptr_record_fqdn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "FQDN of the PTR record created by NetBox for an A or AAAA record",
+			},
+			"ptr_record_zone_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the reverse zone of the PTR record created by NetBox for an A or AAAA record",
+			},
+			"address_record_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the A or AAAA record a PTR record was created by NetBox for",
+			},
+			"fqdn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Fully qualified domain name of the record",
+			},
+			"absolute_value": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Value of the record with the names it contains made absolute",
+			},
+			"active": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if the record is published, i.e. both the record and its zone are active",
+			},
+			"managed": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if the record was generated by NetBox. Such records (SOA, NS and PTR records) can not be managed by Terraform.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tags":              tagsResourceAttribute("record"),
+			"tags_all":          tagsAllResourceAttribute("record"),
+			"tenant_id":         tenantIDResourceAttribute("record"),
+			"custom_fields":     customFieldsResourceAttribute("record"),
+			"custom_fields_all": customFieldsAllResourceAttribute("record"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the record in NetBox, used to detect modifications made outside of Terraform",
+			},
 			"ttl": schema.Int64Attribute{
 				MarkdownDescription: "Record TTL",
 				Optional:            true,
 			},
-
 		},
 	}
 }
 
 func (r *RecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = configureResourceClient(req, resp)
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the record currently in Netbox. It returns nil without
+// error when the record does not exist anymore.
+func (r *RecordResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.Record {
+	httpRes, err := r.client.PluginsNetboxDnsRecordsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve record: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse record: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, recordAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
 }
 
 func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -148,7 +334,11 @@ func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	r.resolveZone(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -164,11 +354,11 @@ func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 	if res.JSON201 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, recordAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON201, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -206,11 +396,14 @@ func (r *RecordResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		if removeNotFound(ctx, httpRes, resp, "record", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, recordAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -219,18 +412,54 @@ func (r *RecordResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *RecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data RecordResourceModel
+	var data, state RecordResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.resolveZone(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the record was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpRes, err := r.client.PluginsNetboxDnsRecordsUpdate(ctx, int(data.ID.ValueInt64()), params)
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The record %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "record", req, state.LastUpdated, remote.LastUpdated, &current, recordAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsRecordsUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, recordAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build record update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsRecordsPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update record: %s", err))
 		return
@@ -241,11 +470,11 @@ func (r *RecordResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, recordAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -263,6 +492,22 @@ func (r *RecordResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	// Check the record was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "record already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "record", req.State, data.LastUpdated, remote.LastUpdated, &current, recordAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	httpRes, err := r.client.PluginsNetboxDnsRecordsDestroy(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy record: %s", err))
@@ -273,12 +518,207 @@ func (r *RecordResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
 		return
 	}
-	if res.StatusCode() != http.StatusNoContent {
+	if !isDeleted(ctx, res.StatusCode(), "record") {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy record: %s", string(res.Body)))
 		return
 	}
 }
 
+// ModifyPlan refuses to create or modify the records managed by NetBox, checks
+// `disable_ptr` is only set on address records, plans the attributes computed
+// by NetBox, and applies the provider default tags, tenant and custom fields.
+func (r *RecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state RecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Type.ValueString() == string(client.RecordTypeSOA) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Managed Record",
+			"SOA records are generated by NetBox from the SOA attributes of the zone and can not be created by Terraform.")
+		return
+	}
+	if state.Managed.ValueBool() && !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.AddError("Managed Record",
+			fmt.Sprintf("The %s record %s (id %d) is generated by NetBox and can not be modified by Terraform. Change the zone or the address record it is derived from instead, or remove it from the configuration and the state.",
+				state.Type.ValueString(), state.FQDN.ValueString(), state.ID.ValueInt64()))
+		return
+	}
+
+	if plan.DisablePtr.ValueBool() && !plan.Type.IsUnknown() && !isAddressRecordType(plan.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("disable_ptr"), "Invalid Attribute Combination",
+			fmt.Sprintf("disable_ptr can only be set on A and AAAA records, not on %s records.", plan.Type.ValueString()))
+		return
+	}
+
+	var config RecordResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.planZone(ctx, &plan, state, config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	creating := req.State.Raw.IsNull()
+	if creating || !plan.Type.Equal(state.Type) || !plan.Name.Equal(state.Name) || !plan.ZoneID.Equal(state.ZoneID) {
+		r.checkGeneratedRecord(ctx, plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.planPTR(state, !creating)
+	if !req.State.Raw.IsNull() {
+		plan.planNames(state)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.provider.planMetadata(ctx, req, resp)
+}
+
+// planZone resolves the zone configured by name into its ID.
+func (r *RecordResource) planZone(ctx context.Context, plan *RecordResourceModel, state, config RecordResourceModel, diags *diag.Diagnostics) {
+	var zoneID func(name string) *int
+	if r.provider != nil && r.provider.Client != nil {
+		zoneID = func(name string) *int {
+			return r.provider.zoneID(ctx, name, config.ViewName.ValueString(), true, path.Root("zone_name"), diags)
+		}
+	}
+	// a zone of another view is looked up again
+	stateZoneName := state.ZoneName
+	if config.ViewName.IsUnknown() || !config.ViewName.IsNull() && !config.ViewName.Equal(state.ViewName) {
+		stateZoneName = types.StringNull()
+	}
+	planNamedID(config.ZoneID, config.ZoneName, state.ZoneID, stateZoneName, &plan.ZoneID, &plan.ZoneName, zoneID)
+	if config.ViewName.IsNull() && !plan.ZoneID.Equal(state.ZoneID) {
+		plan.ViewName = types.StringUnknown()
+	}
+}
+
+// checkGeneratedRecord refuses the records NetBox generates itself, besides
+// the SOA record: the NS records at the zone apex, generated from the
+// nameservers of the zone, and the PTR records generated from address
+// records, which NetBox refuses to duplicate.
+func (r *RecordResource) checkGeneratedRecord(ctx context.Context, plan RecordResourceModel, diags *diag.Diagnostics) {
+	switch plan.Type.ValueString() {
+	case string(client.RecordTypeNS):
+		name := strings.TrimSuffix(plan.Name.ValueString(), ".")
+		if plan.Name.ValueString() == "@" || !plan.ZoneName.IsUnknown() && name == strings.TrimSuffix(plan.ZoneName.ValueString(), ".") {
+			diags.AddAttributeError(path.Root("name"), "Managed Record",
+				"NS records at the apex of the zone are generated by NetBox from the nameservers of the zone and can not be created by Terraform. Set the nameserver_ids of the netboxdns_zone resource instead.")
+		}
+	case string(client.RecordTypePTR):
+		if r.client == nil || plan.Name.IsUnknown() || plan.ZoneID.IsUnknown() {
+			return
+		}
+		params := client.PluginsNetboxDnsRecordsListParams{
+			Type:    stringFilter(plan.Type),
+			Name:    stringFilter(plan.Name),
+			ZoneId:  intFilter(plan.ZoneID),
+			Managed: fromBoolValue(types.BoolValue(true)),
+		}
+		for _, record := range listRecords(ctx, r.client, params, diags) {
+			address := "an address record"
+			if record.AddressRecord != nil && record.AddressRecord.Display != nil {
+				address = "the address record " + *record.AddressRecord.Display
+			}
+			diags.AddAttributeError(path.Root("name"), "Managed Record",
+				fmt.Sprintf("The PTR record %s is generated by NetBox from %s. Set disable_ptr = true on the address record to manage its PTR record with Terraform.",
+					maybeStringValue(record.Fqdn).ValueString(), address))
+		}
+	}
+}
+
+// resolveZone resolves the zone configured by name that was not found in
+// Netbox at plan time, created by the same apply.
+func (r *RecordResource) resolveZone(ctx context.Context, data *RecordResourceModel, diags *diag.Diagnostics) {
+	resolveNamedID(&data.ZoneID, data.ZoneName, func(name string) *int {
+		return r.provider.zoneID(ctx, name, data.ViewName.ValueString(), false, path.Root("zone_name"), diags)
+	})
+}
+
+// planPTR sets the PTR record and address record attributes known before
+// apply: they are null for the records NetBox does not link, and keep their
+// value when none of the attributes NetBox derives them from changed.
+func (m *RecordResourceModel) planPTR(state RecordResourceModel, exists bool) {
+	if !m.Type.IsUnknown() && m.Type.ValueString() != string(client.RecordTypePTR) {
+		m.AddressRecordID = types.Int64Null()
+	}
+	if (!m.Type.IsUnknown() && !isAddressRecordType(m.Type.ValueString())) || m.DisablePtr.ValueBool() || m.Status.ValueString() == string(client.RecordStatusInactive) {
+		m.PtrRecordID = types.Int64Null()
+		m.PtrRecordFQDN = types.StringNull()
+		m.PtrRecordZoneID = types.Int64Null()
+	}
+	if !exists {
+		return
+	}
+	unchanged := m.Name.Equal(state.Name) && m.ZoneID.Equal(state.ZoneID) && m.Type.Equal(state.Type) &&
+		m.Value.Equal(state.Value) && m.Status.Equal(state.Status) && m.DisablePtr.Equal(state.DisablePtr)
+	if !unchanged {
+		return
+	}
+	if m.PtrRecordID.IsUnknown() {
+		m.PtrRecordID = state.PtrRecordID
+		m.PtrRecordFQDN = state.PtrRecordFQDN
+		m.PtrRecordZoneID = state.PtrRecordZoneID
+	}
+	if m.AddressRecordID.IsUnknown() {
+		m.AddressRecordID = state.AddressRecordID
+	}
+}
+
+// planNames keeps the names and activity computed by NetBox when the
+// attributes they are derived from did not change.
+func (m *RecordResourceModel) planNames(state RecordResourceModel) {
+	sameZone := m.ZoneID.Equal(state.ZoneID)
+	if m.FQDN.IsUnknown() && sameZone && m.Name.Equal(state.Name) {
+		m.FQDN = state.FQDN
+	}
+	if m.AbsoluteValue.IsUnknown() && sameZone && m.Type.Equal(state.Type) && m.Value.Equal(state.Value) {
+		m.AbsoluteValue = state.AbsoluteValue
+	}
+	if m.Active.IsUnknown() && sameZone && m.Status.Equal(state.Status) {
+		m.Active = state.Active
+	}
+}
+
+// isAddressRecordType returns true for the record types NetBox creates PTR
+// records for.
+func isAddressRecordType(recordType string) bool {
+	return recordType == string(client.RecordTypeA) || recordType == string(client.RecordTypeAAAA)
+}
+
+// ImportState refuses to import the records managed by NetBox.
 func (r *RecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importByInt64ID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var id types.Int64
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remote := r.retrieve(ctx, id, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || remote == nil {
+		return
+	}
+	if remote.Managed != nil && *remote.Managed {
+		resp.Diagnostics.AddError("Managed Record",
+			fmt.Sprintf("The %s record %s (id %d) is generated by NetBox and can not be managed by Terraform. Use the netboxdns_record data source to reference it.",
+				remote.Type, maybeStringValue(remote.Fqdn).ValueString(), id.ValueInt64()))
+	}
 }
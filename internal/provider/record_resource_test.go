@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestRecordPlanPTR(t *testing.T) {
+	state := RecordResourceModel{
+		Name:            types.StringValue("www"),
+		ZoneID:          types.Int64Value(2),
+		Type:            types.StringValue("A"),
+		Value:           types.StringValue("192.0.2.1"),
+		Status:          types.StringValue("active"),
+		DisablePtr:      types.BoolValue(false),
+		Description:     types.StringValue("web server"),
+		PtrRecordID:     types.Int64Value(10),
+		PtrRecordFQDN:   types.StringValue("1.2.0.192.in-addr.arpa."),
+		PtrRecordZoneID: types.Int64Value(3),
+		AddressRecordID: types.Int64Null(),
+	}
+	unknown := func(m RecordResourceModel) RecordResourceModel {
+		m.PtrRecordID = types.Int64Unknown()
+		m.PtrRecordFQDN = types.StringUnknown()
+		m.PtrRecordZoneID = types.Int64Unknown()
+		m.AddressRecordID = types.Int64Unknown()
+		return m
+	}
+
+	tests := map[string]struct {
+		plan    RecordResourceModel
+		exists  bool
+		wantPtr types.Int64
+	}{
+		"new address record": {
+			plan:    unknown(state),
+			wantPtr: types.Int64Unknown(),
+		},
+		"new CNAME record": {
+			plan: func() RecordResourceModel {
+				m := unknown(state)
+				m.Type = types.StringValue("CNAME")
+				return m
+			}(),
+			wantPtr: types.Int64Null(),
+		},
+		"PTR disabled": {
+			plan: func() RecordResourceModel {
+				m := unknown(state)
+				m.DisablePtr = types.BoolValue(true)
+				return m
+			}(),
+			exists:  true,
+			wantPtr: types.Int64Null(),
+		},
+		"description changed": {
+			plan: func() RecordResourceModel {
+				m := unknown(state)
+				m.Description = types.StringValue("new web server")
+				return m
+			}(),
+			exists:  true,
+			wantPtr: types.Int64Value(10),
+		},
+		"value changed": {
+			plan: func() RecordResourceModel {
+				m := unknown(state)
+				m.Value = types.StringValue("192.0.2.2")
+				return m
+			}(),
+			exists:  true,
+			wantPtr: types.Int64Unknown(),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := test.plan
+			plan.planPTR(state, test.exists)
+			if !plan.PtrRecordID.Equal(test.wantPtr) {
+				t.Errorf("expected ptr_record_id %s, got %s", test.wantPtr, plan.PtrRecordID)
+			}
+			if !plan.AddressRecordID.IsNull() {
+				t.Errorf("expected null address_record_id, got %s", plan.AddressRecordID)
+			}
+		})
+	}
+}
+
+func TestRecordModifyPlanManaged(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRecordResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	record := RecordResourceModel{
+		ID:              types.Int64Value(1),
+		Name:            types.StringValue("1"),
+		ZoneID:          types.Int64Value(3),
+		Type:            types.StringValue("PTR"),
+		Value:           types.StringValue("www.example.com."),
+		Status:          types.StringValue("active"),
+		DisablePtr:      types.BoolValue(false),
+		Managed:         types.BoolValue(true),
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+	soa := record
+	soa.ID = types.Int64Unknown()
+	soa.Type = types.StringValue("SOA")
+	soa.Managed = types.BoolUnknown()
+	modified := record
+	modified.TTL = types.Int64Value(60)
+
+	tests := map[string]struct {
+		state     *RecordResourceModel
+		plan      RecordResourceModel
+		wantError bool
+	}{
+		"create SOA":        {plan: soa, wantError: true},
+		"modify managed":    {state: &record, plan: modified, wantError: true},
+		"unchanged managed": {state: &record, plan: record},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := plan.Set(ctx, &test.plan); diags.HasError() {
+				t.Fatal(diags)
+			}
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			if test.state != nil {
+				if diags := state.Set(ctx, test.state); diags.HasError() {
+					t.Fatal(diags)
+				}
+			}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+
+			(&RecordResource{}).ModifyPlan(ctx, req, resp)
+			if got := resp.Diagnostics.HasError(); got != test.wantError {
+				t.Errorf("expected error %t, got %s", test.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestRecordCheckGeneratedRecord(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		results := ""
+		if r.URL.Query().Get("name") == "1" {
+			results = `{"id": 9, "name": "1", "type": "PTR", "value": "www.example.com.", "fqdn": "1.2.0.192.in-addr.arpa.", "managed": true,
+				"address_record": {"id": 8, "name": "www", "type": "A", "value": "192.0.2.1", "display": "www.example.com [A]"}}`
+		}
+		fmt.Fprintf(w, `{"count": 1, "next": null, "results": [%s]}`, results)
+	}))
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &RecordResource{client: c}
+
+	tests := map[string]struct {
+		recordType string
+		name       string
+		zoneName   types.String
+		wantError  bool
+	}{
+		"apex NS":              {recordType: "NS", name: "@", zoneName: types.StringUnknown(), wantError: true},
+		"apex NS by zone name": {recordType: "NS", name: "example.com.", zoneName: types.StringValue("example.com"), wantError: true},
+		"delegation NS":        {recordType: "NS", name: "sub", zoneName: types.StringValue("example.com")},
+		"generated PTR":        {recordType: "PTR", name: "1", zoneName: types.StringValue("2.0.192.in-addr.arpa"), wantError: true},
+		"PTR":                  {recordType: "PTR", name: "2", zoneName: types.StringValue("2.0.192.in-addr.arpa")},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := RecordResourceModel{
+				Name:     types.StringValue(test.name),
+				ZoneID:   types.Int64Value(3),
+				ZoneName: test.zoneName,
+				Type:     types.StringValue(test.recordType),
+			}
+			var diags diag.Diagnostics
+			r.checkGeneratedRecord(context.Background(), plan, &diags)
+			if got := diags.HasError(); got != test.wantError {
+				t.Errorf("expected error %t, got %s", test.wantError, diags)
+			}
+		})
+	}
+	if want := "limit=1000&managed=true&name=1&offset=0&type=PTR&zone_id=3"; !slices.Contains(queries, want) {
+		t.Errorf("expected query %s, got %s", want, queries)
+	}
+}
+
+func TestRecordToAPIModelUnknownZone(t *testing.T) {
+	m := RecordResourceModel{
+		Name:         types.StringValue("www"),
+		ZoneID:       types.Int64Unknown(),
+		ZoneName:     types.StringValue("example.com"),
+		Type:         types.StringValue("A"),
+		Value:        types.StringValue("192.0.2.1"),
+		Tags:         types.SetNull(types.StringType),
+		CustomFields: types.MapNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	m.ToAPIModel(context.Background(), &diags)
+	if !diags.HasError() {
+		t.Error("expected an error for the unknown zone")
+	}
+}
+
+func TestNestedRecordFQDN(t *testing.T) {
+	zone := &client.NestedZone{Name: "example.com"}
+	tests := map[string]struct {
+		record client.NestedRecord
+		want   types.String
+	}{
+		"name":    {record: client.NestedRecord{Name: "www", Zone: zone}, want: types.StringValue("www.example.com.")},
+		"apex":    {record: client.NestedRecord{Name: "@", Zone: zone}, want: types.StringValue("example.com.")},
+		"no zone": {record: client.NestedRecord{Name: "www"}, want: types.StringNull()},
+		"reverse": {record: client.NestedRecord{Name: "1", Zone: &client.NestedZone{Name: "2.0.192.in-addr.arpa"}}, want: types.StringValue("1.2.0.192.in-addr.arpa.")},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := nestedRecordFQDN(&test.record); !got.Equal(test.want) {
+				t.Errorf("expected %s, got %s", test.want, got)
+			}
+		})
+	}
+}
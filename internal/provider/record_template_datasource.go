@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RecordTemplateDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &RecordTemplateDataSource{}
+
+func NewRecordTemplateDataSource() datasource.DataSource {
+	return &RecordTemplateDataSource{}
+}
+
+type RecordTemplateDataSource struct {
+	client *client.Client
+}
+
+type RecordTemplateDataSourceModel struct {
+	ID              types.Int64   `tfsdk:"id"`
+	Name            types.String  `tfsdk:"name"`
+	RecordName      types.String  `tfsdk:"record_name"`
+	Type            types.String  `tfsdk:"type"`
+	Value           types.String  `tfsdk:"value"`
+	Status          types.String  `tfsdk:"status"`
+	TTL             types.Int64   `tfsdk:"ttl"`
+	DisablePTR      types.Bool    `tfsdk:"disable_ptr"`
+	Description     types.String  `tfsdk:"description"`
+	ZoneTemplateIDs []types.Int64 `tfsdk:"zone_template_ids"`
+	Tags            types.Set     `tfsdk:"tags"`
+	TenantID        types.Int64   `tfsdk:"tenant_id"`
+	CustomFields    types.Map     `tfsdk:"custom_fields"`
+}
+
+func (m *RecordTemplateDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.RecordTemplate, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.RecordName = maybeStringValue(&resp.RecordName)
+	m.Type = maybeStringValue((*string)(&resp.Type))
+	m.Value = maybeStringValue(&resp.Value)
+	m.Status = maybeStringValue((*string)(resp.Status))
+	m.TTL = maybeInt64Value(resp.Ttl)
+	m.DisablePTR = maybeBoolValue(resp.DisablePtr)
+	m.Description = maybeStringValue(resp.Description)
+
+	m.ZoneTemplateIDs = []types.Int64{}
+	if resp.ZoneTemplates != nil {
+		for _, element := range *resp.ZoneTemplates {
+			m.ZoneTemplateIDs = append(m.ZoneTemplateIDs, maybeInt64Value(element.Id))
+		}
+	}
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
+}
+
+func (d *RecordTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_record_template"
+}
+
+var recordTemplateDataSchema = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		MarkdownDescription: "Record template name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"record_name": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Name of the records created from the template",
+	},
+	"type": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "DNS Record type",
+	},
+	"value": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Value of the records created from the template",
+	},
+	"status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Status of the records created from the template",
+	},
+	"ttl": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "TTL of the records created from the template",
+	},
+	"disable_ptr": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: "True if no PTR record is created for the address records created from the template",
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Record template description",
+	},
+	"zone_template_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: "IDs of the zone templates using the record template",
+	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
+}
+
+func (d *RecordTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "record template data source",
+		Attributes:          recordTemplateDataSchema,
+	}
+}
+
+func (d *RecordTemplateDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *RecordTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *RecordTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RecordTemplateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var obj *client.RecordTemplate
+	if !data.ID.IsNull() {
+		obj = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		obj = d.lookupByName(ctx, data.Name, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FillFromAPIModel(ctx, obj, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RecordTemplateDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.RecordTemplate {
+	httpRes, err := d.client.PluginsNetboxDnsRecordtemplatesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve record template: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordtemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse record template: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookupByName finds the record template with the given name, which must match
+// exactly one record template.
+func (d *RecordTemplateDataSource) lookupByName(ctx context.Context, name types.String, diags *diag.Diagnostics) *client.RecordTemplate {
+	params := client.PluginsNetboxDnsRecordtemplatesListParams{
+		Name: &[]string{name.ValueString()},
+	}
+	httpRes, err := d.client.PluginsNetboxDnsRecordtemplatesList(ctx, &params)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to list record templates: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordtemplatesListResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse record templates: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	switch len(res.JSON200.Results) {
+	case 0:
+		diags.AddAttributeError(path.Root("name"), "Record template not found", fmt.Sprintf("No record template named %q found in Netbox", name.ValueString()))
+		return nil
+	case 1:
+		return &res.JSON200.Results[0]
+	default:
+		diags.AddAttributeError(path.Root("name"), "Multiple record templates found", fmt.Sprintf("%d record templates named %q found in Netbox", len(res.JSON200.Results), name.ValueString()))
+		return nil
+	}
+}
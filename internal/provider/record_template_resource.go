@@ -0,0 +1,416 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordTemplateResource{}
+var _ resource.ResourceWithImportState = &RecordTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &RecordTemplateResource{}
+
+func NewRecordTemplateResource() resource.Resource {
+	return &RecordTemplateResource{}
+}
+
+// RecordTemplateResource defines the resource implementation.
+type RecordTemplateResource struct {
+	client   *client.Client
+	provider *configuredProvider
+}
+
+// RecordTemplateResourceModel describes the resource data model.
+type RecordTemplateResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	RecordName      types.String `tfsdk:"record_name"`
+	Type            types.String `tfsdk:"type"`
+	Value           types.String `tfsdk:"value"`
+	Status          types.String `tfsdk:"status"`
+	TTL             types.Int64  `tfsdk:"ttl"`
+	DisablePTR      types.Bool   `tfsdk:"disable_ptr"`
+	Description     types.String `tfsdk:"description"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	TenantID        types.Int64  `tfsdk:"tenant_id"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+// recordTemplateAPIAttributes maps Netbox API fields to record template resource attributes.
+var recordTemplateAPIAttributes = map[string]string{
+	"name":          "name",
+	"record_name":   "record_name",
+	"type":          "type",
+	"value":         "value",
+	"status":        "status",
+	"ttl":           "ttl",
+	"disable_ptr":   "disable_ptr",
+	"description":   "description",
+	"tags":          "tags",
+	"tenant":        "tenant_id",
+	"custom_fields": "custom_fields",
+}
+
+func (m *RecordTemplateResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.RecordTemplateRequest {
+	p := client.RecordTemplateRequest{}
+	p.Name = m.Name.ValueString()
+	p.RecordName = m.RecordName.ValueString()
+	p.Type = client.RecordTemplateRequestType(m.Type.ValueString())
+	p.Value = m.Value.ValueString()
+	if !m.Status.IsNull() && !m.Status.IsUnknown() {
+		status := client.RecordTemplateRequestStatus(m.Status.ValueString())
+		p.Status = &status
+	}
+	p.Ttl = fromInt64Value(m.TTL)
+	p.DisablePtr = fromBoolValue(m.DisablePTR)
+	p.Description = m.Description.ValueStringPointer()
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
+
+	return p
+}
+
+func (m *RecordTemplateResourceModel) FillFromAPIModel(ctx context.Context, resp *client.RecordTemplate, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.RecordName = maybeStringValue(&resp.RecordName)
+	m.Type = maybeStringValue((*string)(&resp.Type))
+	m.Value = maybeStringValue(&resp.Value)
+	m.Status = maybeStringValue((*string)(resp.Status))
+	m.TTL = maybeInt64Value(resp.Ttl)
+	m.DisablePTR = maybeBoolValue(resp.DisablePtr)
+	m.Description = maybeStringValue(resp.Description)
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
+}
+
+func (r *RecordTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_record_template"
+}
+
+func (r *RecordTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Record template resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Record template id in NetBox",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Record template name",
+				Required:            true,
+			},
+			"record_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the records created from the template",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "DNS Record type (A, CNAME, etc.)",
+				Required:            true,
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Value of the records created from the template",
+				Required:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the records created from the template (active or inactive). Defaults to active.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(client.RecordTemplateStatusActive)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.RecordTemplateStatusActive),
+						string(client.RecordTemplateStatusInactive),
+					),
+				},
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "TTL of the records created from the template",
+				Optional:            true,
+			},
+			"disable_ptr": schema.BoolAttribute{
+				MarkdownDescription: "Disable the creation of PTR records for the address records created from the template. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Record template description",
+				Optional:            true,
+			},
+			"tags":              tagsResourceAttribute("record template"),
+			"tags_all":          tagsAllResourceAttribute("record template"),
+			"tenant_id":         tenantIDResourceAttribute("record template"),
+			"custom_fields":     customFieldsResourceAttribute("record template"),
+			"custom_fields_all": customFieldsAllResourceAttribute("record template"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the record template in NetBox, used to detect modifications made outside of Terraform",
+			},
+		},
+	}
+}
+
+func (r *RecordTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the record template currently in Netbox. It returns nil without
+// error when the record template does not exist anymore.
+func (r *RecordTemplateResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.RecordTemplate {
+	httpRes, err := r.client.PluginsNetboxDnsRecordtemplatesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve record template: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordtemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse record template: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, recordTemplateAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
+}
+
+func (r *RecordTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecordTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsRecordtemplatesCreate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create record template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordtemplatesCreateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse record template response: %s", err))
+		return
+	}
+	if res.JSON201 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, recordTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecordTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Internal Error", "Missing ID value")
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsRecordtemplatesRetrieve(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve record template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordtemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse record template: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		if removeNotFound(ctx, httpRes, resp, "record template", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, recordTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RecordTemplateResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the record template was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The record template %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "record template", req, state.LastUpdated, remote.LastUpdated, &current, recordTemplateAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsRecordtemplatesUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, recordTemplateAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build record template update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsRecordtemplatesPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update record template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordtemplatesUpdateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse record template response: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, recordTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecordTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecordTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the record template was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "record template already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "record template", req.State, data.LastUpdated, remote.LastUpdated, &current, recordTemplateAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsRecordtemplatesDestroy(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy record template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRecordtemplatesDestroyResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
+		return
+	}
+	if !isDeleted(ctx, res.StatusCode(), "record template") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy record template: %s", string(res.Body)))
+		return
+	}
+}
+
+// ModifyPlan applies the provider default tags, tenant and custom fields.
+func (r *RecordTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
+}
+
+func (r *RecordTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByInt64ID(ctx, req, resp)
+}
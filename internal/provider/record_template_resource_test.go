@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestRecordTemplateToAPIModel(t *testing.T) {
+	ctx := context.Background()
+	m := RecordTemplateResourceModel{
+		Name:            types.StringValue("mail"),
+		RecordName:      types.StringValue("@"),
+		Type:            types.StringValue("MX"),
+		Value:           types.StringValue("10 mail.example.com."),
+		Status:          types.StringUnknown(),
+		TTL:             types.Int64Null(),
+		DisablePTR:      types.BoolNull(),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	p := m.ToAPIModel(ctx, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	// the template is named apart from the records it creates
+	if p.Name != "mail" || p.RecordName != "@" {
+		t.Errorf("expected template mail for record @, got %s for record %s", p.Name, p.RecordName)
+	}
+	if p.Type != client.RecordTemplateRequestTypeMX {
+		t.Errorf("expected type MX, got %s", p.Type)
+	}
+	// the records created from the template get the TTL of their zone
+	if p.Ttl != nil {
+		t.Errorf("expected no TTL, got %d", *p.Ttl)
+	}
+	if p.Status != nil || p.DisablePtr != nil {
+		t.Errorf("expected the Netbox default status and disable_ptr, got %v and %v", p.Status, p.DisablePtr)
+	}
+}
+
+func TestRecordTemplateFillFromAPIModel(t *testing.T) {
+	ctx := context.Background()
+	var resp client.RecordTemplate
+	if err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"name": "www",
+		"record_name": "www",
+		"type": "AAAA",
+		"value": "2001:db8::1",
+		"status": "inactive",
+		"ttl": null,
+		"disable_ptr": true
+	}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	m := RecordTemplateResourceModel{
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	m.FillFromAPIModel(ctx, &resp, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !m.RecordName.Equal(types.StringValue("www")) || !m.Value.Equal(types.StringValue("2001:db8::1")) {
+		t.Errorf("expected record www with value 2001:db8::1, got %s with value %s", m.RecordName, m.Value)
+	}
+	if !m.TTL.IsNull() {
+		t.Errorf("expected null ttl, got %s", m.TTL)
+	}
+	if !m.DisablePTR.Equal(types.BoolValue(true)) {
+		t.Errorf("expected disable_ptr true, got %s", m.DisablePTR)
+	}
+}
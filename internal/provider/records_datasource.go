@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RecordsDataSource{}
+
+func NewRecordsDataSource() datasource.DataSource {
+	return &RecordsDataSource{}
+}
+
+type RecordsDataSource struct {
+	client *client.Client
+}
+
+type RecordsDataSourceModel struct {
+	Name                 types.String                        `tfsdk:"name"`
+	NameContains         types.String                        `tfsdk:"name_contains"`
+	NameStartsWith       types.String                        `tfsdk:"name_starts_with"`
+	NameNot              types.String                        `tfsdk:"name_not"`
+	Value                types.String                        `tfsdk:"value"`
+	ValueContains        types.String                        `tfsdk:"value_contains"`
+	ValueStartsWith      types.String                        `tfsdk:"value_starts_with"`
+	ValueNot             types.String                        `tfsdk:"value_not"`
+	Type                 types.String                        `tfsdk:"type"`
+	TypeContains         types.String                        `tfsdk:"type_contains"`
+	TypeStartsWith       types.String                        `tfsdk:"type_starts_with"`
+	TypeNot              types.String                        `tfsdk:"type_not"`
+	ZoneID               types.Int64                         `tfsdk:"zone_id"`
+	Zone                 types.String                        `tfsdk:"zone"`
+	ViewID               types.Int64                         `tfsdk:"view_id"`
+	View                 types.String                        `tfsdk:"view"`
+	Tags                 types.Set                           `tfsdk:"tags"`
+	TenantID             types.Int64                         `tfsdk:"tenant_id"`
+	TTLMin               types.Int64                         `tfsdk:"ttl_min"`
+	TTLMax               types.Int64                         `tfsdk:"ttl_max"`
+	Status               types.String                        `tfsdk:"status"`
+	IPAddress            types.String                        `tfsdk:"ip_address"`
+	Managed              types.Bool                          `tfsdk:"managed"`
+	RFC2317CnameRecordID types.Int64                         `tfsdk:"rfc2317_cname_record_id"`
+	Records              []RecordsDataSourceEntry            `tfsdk:"records"`
+	RecordsByFQDNType    map[string][]RecordsDataSourceEntry `tfsdk:"records_by_fqdn_type"`
+}
+
+// RecordsDataSourceEntry describes a record returned by the records data source.
+type RecordsDataSourceEntry struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	FQDN            types.String `tfsdk:"fqdn"`
+	ZoneID          types.Int64  `tfsdk:"zone_id"`
+	Type            types.String `tfsdk:"type"`
+	Value           types.String `tfsdk:"value"`
+	Status          types.String `tfsdk:"status"`
+	TTL             types.Int64  `tfsdk:"ttl"`
+	Managed         types.Bool   `tfsdk:"managed"`
+	DisablePtr      types.Bool   `tfsdk:"disable_ptr"`
+	PtrRecordID     types.Int64  `tfsdk:"ptr_record_id"`
+	AddressRecordID types.Int64  `tfsdk:"address_record_id"`
+	Description     types.String `tfsdk:"description"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TenantID        types.Int64  `tfsdk:"tenant_id"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+}
+
+func (m *RecordsDataSourceEntry) FillFromAPIModel(ctx context.Context, resp *client.Record, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.FQDN = maybeStringValue(resp.Fqdn)
+	m.ZoneID = types.Int64Null()
+	if resp.Zone != nil {
+		m.ZoneID = maybeInt64Value(resp.Zone.Id)
+	}
+	m.Type = maybeStringValue((*string)(&resp.Type))
+	m.Value = maybeStringValue(&resp.Value)
+	m.Status = maybeStringValue((*string)(resp.Status))
+	m.TTL = maybeInt64Value(resp.Ttl)
+	m.Managed = maybeBoolValue(resp.Managed)
+	m.DisablePtr = maybeBoolValue(resp.DisablePtr)
+	m.PtrRecordID = types.Int64Null()
+	if resp.PtrRecord != nil {
+		m.PtrRecordID = maybeInt64Value(resp.PtrRecord.Id)
+	}
+	m.AddressRecordID = types.Int64Null()
+	if resp.AddressRecord != nil {
+		m.AddressRecordID = maybeInt64Value(resp.AddressRecord.Id)
+	}
+	m.Description = maybeStringValue(resp.Description)
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
+}
+
+// ToListParams returns the Netbox list filters matching the configured filters.
+func (m *RecordsDataSourceModel) ToListParams(ctx context.Context, diags *diag.Diagnostics) client.PluginsNetboxDnsRecordsListParams {
+	params := client.PluginsNetboxDnsRecordsListParams{}
+	params.Name = stringFilter(m.Name)
+	params.NameIc = stringFilter(m.NameContains)
+	params.NameIsw = stringFilter(m.NameStartsWith)
+	params.NameN = stringFilter(m.NameNot)
+	params.Value = stringFilter(m.Value)
+	params.ValueIc = stringFilter(m.ValueContains)
+	params.ValueIsw = stringFilter(m.ValueStartsWith)
+	params.ValueN = stringFilter(m.ValueNot)
+	params.Type = stringFilter(m.Type)
+	params.TypeIc = stringFilter(m.TypeContains)
+	params.TypeIsw = stringFilter(m.TypeStartsWith)
+	params.TypeN = stringFilter(m.TypeNot)
+	params.ZoneId = intFilter(m.ZoneID)
+	params.Zone = stringFilter(m.Zone)
+	params.ViewId = intFilter(m.ViewID)
+	params.View = stringFilter(m.View)
+	params.Tag = tagsFilter(ctx, m.Tags, diags)
+	params.TenantId = intFilter(m.TenantID)
+	params.TtlGte = int32Filter(m.TTLMin)
+	params.TtlLte = int32Filter(m.TTLMax)
+	params.Status = stringFilter(m.Status)
+	params.IpAddress = stringFilter(m.IPAddress)
+	params.Managed = fromBoolValue(m.Managed)
+	params.Rfc2317CnameRecordId = intFilter(m.RFC2317CnameRecordID)
+	return params
+}
+
+// filterAttribute returns the schema of an optional string filter of a list
+// data source.
+func filterAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+	}
+}
+
+// recordsEntryObject is the schema of a record returned by the records data
+// source.
+var recordsEntryObject = schema.NestedAttributeObject{
+	Attributes: map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"fqdn": schema.StringAttribute{
+			Computed: true,
+		},
+		"zone_id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"type": schema.StringAttribute{
+			Computed: true,
+		},
+		"value": schema.StringAttribute{
+			Computed: true,
+		},
+		"status": schema.StringAttribute{
+			Computed: true,
+		},
+		"ttl": schema.Int64Attribute{
+			Computed: true,
+		},
+		"managed": schema.BoolAttribute{
+			Computed: true,
+		},
+		"disable_ptr": schema.BoolAttribute{
+			Computed: true,
+		},
+		"ptr_record_id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"address_record_id": schema.Int64Attribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"tags":          tagsDataSourceAttribute(),
+		"tenant_id":     tenantIDDataSourceAttribute(),
+		"custom_fields": customFieldsDataSourceAttribute(),
+	},
+}
+
+func (d *RecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_records"
+}
+
+func (d *RecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNS Records data source, returning the records matching all the filters set. The CNAME records generated by NetBox for an RFC 2317 zone are found with `zone_id` set to the parent zone of the RFC 2317 zone, `type` set to `CNAME` and `managed` set to true.",
+		Attributes: map[string]schema.Attribute{
+			"name":              filterAttribute("Name of the records"),
+			"name_contains":     filterAttribute("Part of the name of the records, case insensitive"),
+			"name_starts_with":  filterAttribute("Start of the name of the records, case insensitive"),
+			"name_not":          filterAttribute("Name the records must not have"),
+			"value":             filterAttribute("Value of the records"),
+			"value_contains":    filterAttribute("Part of the value of the records, case insensitive"),
+			"value_starts_with": filterAttribute("Start of the value of the records, case insensitive"),
+			"value_not":         filterAttribute("Value the records must not have"),
+			"type":              filterAttribute("DNS Record type (A, CNAME, etc.)"),
+			"type_contains":     filterAttribute("Part of the type of the records, case insensitive"),
+			"type_starts_with":  filterAttribute("Start of the type of the records, case insensitive"),
+			"type_not":          filterAttribute("Type the records must not have"),
+			"zone_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone of the records",
+				Optional:            true,
+			},
+			"zone": filterAttribute("Name of the zone of the records"),
+			"view_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the view of the zone of the records",
+				Optional:            true,
+			},
+			"view": filterAttribute("Name of the view of the zone of the records"),
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Slugs of tags the records must all have",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tenant_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the tenant of the records",
+				Optional:            true,
+			},
+			"ttl_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum TTL of the records",
+				Optional:            true,
+			},
+			"ttl_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum TTL of the records",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: `Status of the records, "active" or "inactive"`,
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.RecordStatusActive),
+						string(client.RecordStatusInactive),
+					),
+				},
+			},
+			"ip_address": filterAttribute("IP address of the address and PTR records"),
+			"managed": schema.BoolAttribute{
+				MarkdownDescription: "True to return only the records generated by NetBox, false to return only the other records",
+				Optional:            true,
+			},
+			"rfc2317_cname_record_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of an RFC 2317 CNAME record, to return the PTR records it points to",
+				Optional:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Records matching the filters",
+				Computed:            true,
+				NestedObject:        recordsEntryObject,
+			},
+			"records_by_fqdn_type": schema.MapAttribute{
+				MarkdownDescription: "Records matching the filters, grouped by FQDN and type with keys like `www.example.com./A`",
+				Computed:            true,
+				ElementType:         types.ListType{ElemType: recordsEntryObject.Type()},
+			},
+		},
+	}
+}
+
+func (d *RecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *RecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToListParams(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	records := listRecords(ctx, d.client, params, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Records = make([]RecordsDataSourceEntry, len(records))
+	data.RecordsByFQDNType = map[string][]RecordsDataSourceEntry{}
+	for i := range records {
+		data.Records[i].FillFromAPIModel(ctx, &records[i], &resp.Diagnostics)
+		key := data.Records[i].FQDN.ValueString() + "/" + data.Records[i].Type.ValueString()
+		data.RecordsByFQDNType[key] = append(data.RecordsByFQDNType[key], data.Records[i])
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestRecordsDataSourceListParams(t *testing.T) {
+	ctx := context.Background()
+	data := RecordsDataSourceModel{
+		NameStartsWith: types.StringValue("web"),
+		ValueNot:       types.StringValue("192.0.2.1"),
+		Type:           types.StringValue("A"),
+		Zone:           types.StringValue("example.com"),
+		Tags:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("production")}),
+		TTLMin:         types.Int64Value(60),
+		TTLMax:         types.Int64Value(3600),
+		Status:         types.StringValue("active"),
+	}
+
+	var diags diag.Diagnostics
+	params := data.ToListParams(ctx, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	req, err := client.NewPluginsNetboxDnsRecordsListRequest("http://netbox", &params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "name__isw=web&status=active&tag=production&ttl__gte=60&ttl__lte=3600&type=A&value__n=192.0.2.1&zone=example.com"
+	if got := req.URL.RawQuery; got != want {
+		t.Errorf("expected query %s, got %s", want, got)
+	}
+}
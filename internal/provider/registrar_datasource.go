@@ -0,0 +1,195 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegistrarDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &RegistrarDataSource{}
+
+func NewRegistrarDataSource() datasource.DataSource {
+	return &RegistrarDataSource{}
+}
+
+type RegistrarDataSource struct {
+	client *client.Client
+}
+
+type RegistrarDataSourceModel struct {
+	ID           types.Int64  `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	IANAID       types.Int64  `tfsdk:"iana_id"`
+	WhoisServer  types.String `tfsdk:"whois_server"`
+	ReferralURL  types.String `tfsdk:"referral_url"`
+	Address      types.String `tfsdk:"address"`
+	AbuseEmail   types.String `tfsdk:"abuse_email"`
+	AbusePhone   types.String `tfsdk:"abuse_phone"`
+	Description  types.String `tfsdk:"description"`
+	CustomFields types.Map    `tfsdk:"custom_fields"`
+}
+
+func (m *RegistrarDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.Registrar, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.IANAID = maybeInt64Value(resp.IanaId)
+	m.WhoisServer = maybeStringValue(resp.WhoisServer)
+	m.ReferralURL = maybeStringValue(resp.ReferralUrl)
+	m.Address = maybeStringValue(resp.Address)
+	m.AbuseEmail = maybeStringValue(resp.AbuseEmail)
+	m.AbusePhone = maybeStringValue(resp.AbusePhone)
+	m.Description = maybeStringValue(resp.Description)
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
+}
+
+func (d *RegistrarDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registrar"
+}
+
+var registrarDataSchema = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		MarkdownDescription: "Registrar name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"iana_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "IANA ID of the registrar",
+	},
+	"whois_server": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "WHOIS server of the registrar",
+	},
+	"referral_url": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "URL of the registrar website",
+	},
+	"address": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Postal address of the registrar",
+	},
+	"abuse_email": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Email address to report abuse to the registrar",
+	},
+	"abuse_phone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Phone number to report abuse to the registrar",
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Registrar description",
+	},
+	"custom_fields": customFieldsDataSourceAttribute(),
+}
+
+func (d *RegistrarDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "registrar data source",
+		Attributes:          registrarDataSchema,
+	}
+}
+
+func (d *RegistrarDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *RegistrarDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *RegistrarDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegistrarDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var obj *client.Registrar
+	if !data.ID.IsNull() {
+		obj = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		obj = d.lookupByName(ctx, data.Name, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FillFromAPIModel(ctx, obj, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RegistrarDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.Registrar {
+	httpRes, err := d.client.PluginsNetboxDnsRegistrarsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve registrar: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRegistrarsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse registrar: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookupByName finds the registrar with the given name, which must match
+// exactly one registrar.
+func (d *RegistrarDataSource) lookupByName(ctx context.Context, name types.String, diags *diag.Diagnostics) *client.Registrar {
+	params := client.PluginsNetboxDnsRegistrarsListParams{
+		Name: &[]string{name.ValueString()},
+	}
+	httpRes, err := d.client.PluginsNetboxDnsRegistrarsList(ctx, &params)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to list registrars: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRegistrarsListResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse registrars: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	switch len(res.JSON200.Results) {
+	case 0:
+		diags.AddAttributeError(path.Root("name"), "Registrar not found", fmt.Sprintf("No registrar named %q found in Netbox", name.ValueString()))
+		return nil
+	case 1:
+		return &res.JSON200.Results[0]
+	default:
+		diags.AddAttributeError(path.Root("name"), "Multiple registrars found", fmt.Sprintf("%d registrars named %q found in Netbox", len(res.JSON200.Results), name.ValueString()))
+		return nil
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RegistrarResource{}
+var _ resource.ResourceWithImportState = &RegistrarResource{}
+var _ resource.ResourceWithModifyPlan = &RegistrarResource{}
+
+func NewRegistrarResource() resource.Resource {
+	return &RegistrarResource{}
+}
+
+// RegistrarResource defines the resource implementation.
+type RegistrarResource struct {
+	client   *client.Client
+	provider *configuredProvider
+}
+
+// RegistrarResourceModel describes the resource data model.
+type RegistrarResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	IANAID          types.Int64  `tfsdk:"iana_id"`
+	WhoisServer     types.String `tfsdk:"whois_server"`
+	ReferralURL     types.String `tfsdk:"referral_url"`
+	Address         types.String `tfsdk:"address"`
+	AbuseEmail      types.String `tfsdk:"abuse_email"`
+	AbusePhone      types.String `tfsdk:"abuse_phone"`
+	Description     types.String `tfsdk:"description"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+// registrarAPIAttributes maps Netbox API fields to registrar resource attributes.
+var registrarAPIAttributes = map[string]string{
+	"name":          "name",
+	"iana_id":       "iana_id",
+	"whois_server":  "whois_server",
+	"referral_url":  "referral_url",
+	"address":       "address",
+	"abuse_email":   "abuse_email",
+	"abuse_phone":   "abuse_phone",
+	"description":   "description",
+	"custom_fields": "custom_fields",
+}
+
+func (m *RegistrarResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.RegistrarRequest {
+	p := client.RegistrarRequest{}
+	p.Name = m.Name.ValueString()
+	p.IanaId = fromInt64Value(m.IANAID)
+	p.WhoisServer = fromStringValue(m.WhoisServer)
+	p.ReferralUrl = fromStringValue(m.ReferralURL)
+	p.Address = fromStringValue(m.Address)
+	p.AbuseEmail = fromStringValue(m.AbuseEmail)
+	p.AbusePhone = fromStringValue(m.AbusePhone)
+	p.Description = fromStringValue(m.Description)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
+
+	return p
+}
+
+func (m *RegistrarResourceModel) FillFromAPIModel(ctx context.Context, resp *client.Registrar, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.IANAID = maybeInt64Value(resp.IanaId)
+	m.WhoisServer = maybeStringValue(resp.WhoisServer)
+	m.ReferralURL = maybeStringValue(resp.ReferralUrl)
+	m.Address = maybeStringValue(resp.Address)
+	m.AbuseEmail = maybeStringValue(resp.AbuseEmail)
+	m.AbusePhone = maybeStringValue(resp.AbusePhone)
+	m.Description = maybeStringValue(resp.Description)
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
+}
+
+func (r *RegistrarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registrar"
+}
+
+func (r *RegistrarResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Domain registrar resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Registrar id in NetBox",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Registrar name",
+				Required:            true,
+			},
+			"iana_id": schema.Int64Attribute{
+				MarkdownDescription: "IANA ID of the registrar",
+				Optional:            true,
+			},
+			"whois_server": schema.StringAttribute{
+				MarkdownDescription: "WHOIS server of the registrar",
+				Optional:            true,
+			},
+			"referral_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the registrar website",
+				Optional:            true,
+			},
+			"address": schema.StringAttribute{
+				MarkdownDescription: "Postal address of the registrar",
+				Optional:            true,
+			},
+			"abuse_email": schema.StringAttribute{
+				MarkdownDescription: "Email address to report abuse to the registrar",
+				Optional:            true,
+			},
+			"abuse_phone": schema.StringAttribute{
+				MarkdownDescription: "Phone number to report abuse to the registrar",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Registrar description",
+				Optional:            true,
+			},
+			"custom_fields":     customFieldsResourceAttribute("registrar"),
+			"custom_fields_all": customFieldsAllResourceAttribute("registrar"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the registrar in NetBox, used to detect modifications made outside of Terraform",
+			},
+		},
+	}
+}
+
+func (r *RegistrarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the registrar currently in Netbox. It returns nil without
+// error when the registrar does not exist anymore.
+func (r *RegistrarResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.Registrar {
+	httpRes, err := r.client.PluginsNetboxDnsRegistrarsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve registrar: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsRegistrarsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse registrar: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, registrarAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
+}
+
+func (r *RegistrarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RegistrarResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsRegistrarsCreate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create registrar: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRegistrarsCreateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse registrar response: %s", err))
+		return
+	}
+	if res.JSON201 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, registrarAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RegistrarResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Internal Error", "Missing ID value")
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsRegistrarsRetrieve(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve registrar: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRegistrarsRetrieveResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse registrar: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		if removeNotFound(ctx, httpRes, resp, "registrar", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, registrarAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RegistrarResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the registrar was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The registrar %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "registrar", req, state.LastUpdated, remote.LastUpdated, &current, registrarAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsRegistrarsUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, registrarAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build registrar update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsRegistrarsPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update registrar: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRegistrarsUpdateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse registrar response: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, registrarAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RegistrarResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the registrar was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "registrar already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "registrar", req.State, data.LastUpdated, remote.LastUpdated, &current, registrarAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsRegistrarsDestroy(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy registrar: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsRegistrarsDestroyResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
+		return
+	}
+	if !isDeleted(ctx, res.StatusCode(), "registrar") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy registrar: %s", string(res.Body)))
+		return
+	}
+}
+
+// ModifyPlan applies the provider default tags, tenant and custom fields.
+func (r *RegistrarResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
+}
+
+func (r *RegistrarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByInt64ID(ctx, req, resp)
+}
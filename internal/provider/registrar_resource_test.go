@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestRegistrarMapping(t *testing.T) {
+	ctx := context.Background()
+	m := RegistrarResourceModel{
+		Name:            types.StringValue("Example Registrar"),
+		IANAID:          types.Int64Value(9999),
+		WhoisServer:     types.StringValue("whois.example.net"),
+		ReferralURL:     types.StringNull(),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	p := m.ToAPIModel(ctx, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if p.IanaId == nil || *p.IanaId != 9999 {
+		t.Errorf("expected IANA ID 9999, got %v", p.IanaId)
+	}
+	if p.ReferralUrl != nil {
+		t.Errorf("expected no referral URL, got %s", *p.ReferralUrl)
+	}
+
+	var resp client.Registrar
+	if err := json.Unmarshal([]byte(`{"id": 1, "name": "Example Registrar", "iana_id": null, "abuse_phone": "+1.5555550100"}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+	m.FillFromAPIModel(ctx, &resp, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !m.IANAID.IsNull() || !m.WhoisServer.IsNull() {
+		t.Errorf("expected the fields unset in Netbox to be null, got iana_id %s and whois_server %s", m.IANAID, m.WhoisServer)
+	}
+	if !m.AbusePhone.Equal(types.StringValue("+1.5555550100")) {
+		t.Errorf("expected abuse_phone +1.5555550100, got %s", m.AbusePhone)
+	}
+}
+
+func TestRegistrationContactMapping(t *testing.T) {
+	ctx := context.Background()
+	m := RegistrationContactResourceModel{
+		ContactID:       types.StringValue("EX-1"),
+		Name:            types.StringValue("Jane Doe"),
+		PhoneExt:        types.StringNull(),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+
+	var diags diag.Diagnostics
+	p := m.ToAPIModel(ctx, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if p.ContactId != "EX-1" || p.PhoneExt != nil {
+		t.Errorf("expected contact EX-1 without phone extension, got %s with %v", p.ContactId, p.PhoneExt)
+	}
+
+	var resp client.RegistrationContact
+	if err := json.Unmarshal([]byte(`{"id": 2, "contact_id": "EX-1", "street": "1 Main Street"}`), &resp); err != nil {
+		t.Fatal(err)
+	}
+	m.FillFromAPIModel(ctx, &resp, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !m.Street.Equal(types.StringValue("1 Main Street")) || !m.Organization.IsNull() {
+		t.Errorf("expected street 1 Main Street and no organization, got %s and %s", m.Street, m.Organization)
+	}
+}
+
+func TestRegistrationContactLookup(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"count": 1, "next": null, "results": [{"id": 2, "contact_id": "EX-1", "name": "Jane Doe"}]}`)
+	}))
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := &RegistrationContactDataSource{client: c}
+
+	var diags diag.Diagnostics
+	params := client.PluginsNetboxDnsContactsListParams{ContactId: &[]string{"EX-1"}}
+	contact := d.lookup(context.Background(), &params, path.Root("contact_id"), `with ID "EX-1"`, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if contact == nil || *contact.Id != 2 {
+		t.Errorf("expected contact 2, got %v", contact)
+	}
+	if len(queries) != 1 || queries[0] != "contact_id=EX-1" {
+		t.Errorf("unexpected queries %v", queries)
+	}
+}
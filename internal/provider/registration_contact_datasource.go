@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RegistrationContactDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &RegistrationContactDataSource{}
+
+func NewRegistrationContactDataSource() datasource.DataSource {
+	return &RegistrationContactDataSource{}
+}
+
+type RegistrationContactDataSource struct {
+	client *client.Client
+}
+
+type RegistrationContactDataSourceModel struct {
+	ID            types.Int64  `tfsdk:"id"`
+	ContactID     types.String `tfsdk:"contact_id"`
+	Name          types.String `tfsdk:"name"`
+	Organization  types.String `tfsdk:"organization"`
+	Street        types.String `tfsdk:"street"`
+	City          types.String `tfsdk:"city"`
+	StateProvince types.String `tfsdk:"state_province"`
+	PostalCode    types.String `tfsdk:"postal_code"`
+	Country       types.String `tfsdk:"country"`
+	Phone         types.String `tfsdk:"phone"`
+	PhoneExt      types.String `tfsdk:"phone_ext"`
+	Fax           types.String `tfsdk:"fax"`
+	FaxExt        types.String `tfsdk:"fax_ext"`
+	Email         types.String `tfsdk:"email"`
+	Description   types.String `tfsdk:"description"`
+	CustomFields  types.Map    `tfsdk:"custom_fields"`
+}
+
+func (m *RegistrationContactDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.RegistrationContact, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.ContactID = maybeStringValue(&resp.ContactId)
+	m.Name = maybeStringValue(resp.Name)
+	m.Organization = maybeStringValue(resp.Organization)
+	m.Street = maybeStringValue(resp.Street)
+	m.City = maybeStringValue(resp.City)
+	m.StateProvince = maybeStringValue(resp.StateProvince)
+	m.PostalCode = maybeStringValue(resp.PostalCode)
+	m.Country = maybeStringValue(resp.Country)
+	m.Phone = maybeStringValue(resp.Phone)
+	m.PhoneExt = maybeStringValue(resp.PhoneExt)
+	m.Fax = maybeStringValue(resp.Fax)
+	m.FaxExt = maybeStringValue(resp.FaxExt)
+	m.Email = maybeStringValue(resp.Email)
+	m.Description = maybeStringValue(resp.Description)
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
+}
+
+func (d *RegistrationContactDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registration_contact"
+}
+
+var registrationContactDataSchema = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name` and `contact_id`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"contact_id": schema.StringAttribute{
+		MarkdownDescription: "Registry ID of the contact to use for lookup. Conflicts with `id` and `name`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		MarkdownDescription: "Registration contact name to use for lookup. Conflicts with `id` and `contact_id`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"organization": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Organization of the contact",
+	},
+	"street": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Street address of the contact",
+	},
+	"city": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "City of the contact",
+	},
+	"state_province": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "State or province of the contact",
+	},
+	"postal_code": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Postal code of the contact",
+	},
+	"country": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Country of the contact, as a two-letter ISO 3166 code",
+	},
+	"phone": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Phone number of the contact",
+	},
+	"phone_ext": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Phone number extension of the contact",
+	},
+	"fax": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Fax number of the contact",
+	},
+	"fax_ext": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Fax number extension of the contact",
+	},
+	"email": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Email address of the contact",
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Registration contact description",
+	},
+	"custom_fields": customFieldsDataSourceAttribute(),
+}
+
+func (d *RegistrationContactDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "registration contact data source",
+		Attributes:          registrationContactDataSchema,
+	}
+}
+
+func (d *RegistrationContactDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("contact_id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *RegistrationContactDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *RegistrationContactDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RegistrationContactDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var obj *client.RegistrationContact
+	if !data.ID.IsNull() {
+		obj = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else if !data.ContactID.IsNull() {
+		params := client.PluginsNetboxDnsContactsListParams{
+			ContactId: &[]string{data.ContactID.ValueString()},
+		}
+		obj = d.lookup(ctx, &params, path.Root("contact_id"), fmt.Sprintf("with ID %q", data.ContactID.ValueString()), &resp.Diagnostics)
+	} else {
+		params := client.PluginsNetboxDnsContactsListParams{
+			Name: &[]string{data.Name.ValueString()},
+		}
+		obj = d.lookup(ctx, &params, path.Root("name"), fmt.Sprintf("named %q", data.Name.ValueString()), &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FillFromAPIModel(ctx, obj, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *RegistrationContactDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.RegistrationContact {
+	httpRes, err := d.client.PluginsNetboxDnsContactsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve registration contact: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsContactsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse registration contact: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookup finds the registration contact matching the list filters in params,
+// which must match exactly one registration contact. Errors are reported on
+// the attribute used for the lookup, with criteria describing the filters.
+func (d *RegistrationContactDataSource) lookup(ctx context.Context, params *client.PluginsNetboxDnsContactsListParams, attribute path.Path, criteria string, diags *diag.Diagnostics) *client.RegistrationContact {
+	httpRes, err := d.client.PluginsNetboxDnsContactsList(ctx, params)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to list registration contacts: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsContactsListResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse registration contacts: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	switch len(res.JSON200.Results) {
+	case 0:
+		diags.AddAttributeError(attribute, "Registration contact not found", fmt.Sprintf("No registration contact %s found in Netbox", criteria))
+		return nil
+	case 1:
+		return &res.JSON200.Results[0]
+	default:
+		diags.AddAttributeError(attribute, "Multiple registration contacts found", fmt.Sprintf("%d registration contacts %s found in Netbox", len(res.JSON200.Results), criteria))
+		return nil
+	}
+}
@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RegistrationContactResource{}
+var _ resource.ResourceWithImportState = &RegistrationContactResource{}
+var _ resource.ResourceWithModifyPlan = &RegistrationContactResource{}
+
+func NewRegistrationContactResource() resource.Resource {
+	return &RegistrationContactResource{}
+}
+
+// RegistrationContactResource defines the resource implementation.
+type RegistrationContactResource struct {
+	client   *client.Client
+	provider *configuredProvider
+}
+
+// RegistrationContactResourceModel describes the resource data model.
+type RegistrationContactResourceModel struct {
+	ID              types.Int64  `tfsdk:"id"`
+	ContactID       types.String `tfsdk:"contact_id"`
+	Name            types.String `tfsdk:"name"`
+	Organization    types.String `tfsdk:"organization"`
+	Street          types.String `tfsdk:"street"`
+	City            types.String `tfsdk:"city"`
+	StateProvince   types.String `tfsdk:"state_province"`
+	PostalCode      types.String `tfsdk:"postal_code"`
+	Country         types.String `tfsdk:"country"`
+	Phone           types.String `tfsdk:"phone"`
+	PhoneExt        types.String `tfsdk:"phone_ext"`
+	Fax             types.String `tfsdk:"fax"`
+	FaxExt          types.String `tfsdk:"fax_ext"`
+	Email           types.String `tfsdk:"email"`
+	Description     types.String `tfsdk:"description"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+// registrationContactAPIAttributes maps Netbox API fields to registration contact resource attributes.
+var registrationContactAPIAttributes = map[string]string{
+	"contact_id":     "contact_id",
+	"name":           "name",
+	"organization":   "organization",
+	"street":         "street",
+	"city":           "city",
+	"state_province": "state_province",
+	"postal_code":    "postal_code",
+	"country":        "country",
+	"phone":          "phone",
+	"phone_ext":      "phone_ext",
+	"fax":            "fax",
+	"fax_ext":        "fax_ext",
+	"email":          "email",
+	"description":    "description",
+	"custom_fields":  "custom_fields",
+}
+
+func (m *RegistrationContactResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.RegistrationContactRequest {
+	p := client.RegistrationContactRequest{}
+	p.ContactId = m.ContactID.ValueString()
+	p.Name = fromStringValue(m.Name)
+	p.Organization = fromStringValue(m.Organization)
+	p.Street = fromStringValue(m.Street)
+	p.City = fromStringValue(m.City)
+	p.StateProvince = fromStringValue(m.StateProvince)
+	p.PostalCode = fromStringValue(m.PostalCode)
+	p.Country = fromStringValue(m.Country)
+	p.Phone = fromStringValue(m.Phone)
+	p.PhoneExt = fromStringValue(m.PhoneExt)
+	p.Fax = fromStringValue(m.Fax)
+	p.FaxExt = fromStringValue(m.FaxExt)
+	p.Email = fromStringValue(m.Email)
+	p.Description = fromStringValue(m.Description)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
+
+	return p
+}
+
+func (m *RegistrationContactResourceModel) FillFromAPIModel(ctx context.Context, resp *client.RegistrationContact, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.ContactID = maybeStringValue(&resp.ContactId)
+	m.Name = maybeStringValue(resp.Name)
+	m.Organization = maybeStringValue(resp.Organization)
+	m.Street = maybeStringValue(resp.Street)
+	m.City = maybeStringValue(resp.City)
+	m.StateProvince = maybeStringValue(resp.StateProvince)
+	m.PostalCode = maybeStringValue(resp.PostalCode)
+	m.Country = maybeStringValue(resp.Country)
+	m.Phone = maybeStringValue(resp.Phone)
+	m.PhoneExt = maybeStringValue(resp.PhoneExt)
+	m.Fax = maybeStringValue(resp.Fax)
+	m.FaxExt = maybeStringValue(resp.FaxExt)
+	m.Email = maybeStringValue(resp.Email)
+	m.Description = maybeStringValue(resp.Description)
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
+}
+
+func (r *RegistrationContactResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registration_contact"
+}
+
+func (r *RegistrationContactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Domain registration contact resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Registration contact id in NetBox",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"contact_id": schema.StringAttribute{
+				MarkdownDescription: "Registry ID of the contact",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the contact",
+				Optional:            true,
+			},
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "Organization of the contact",
+				Optional:            true,
+			},
+			"street": schema.StringAttribute{
+				MarkdownDescription: "Street address of the contact",
+				Optional:            true,
+			},
+			"city": schema.StringAttribute{
+				MarkdownDescription: "City of the contact",
+				Optional:            true,
+			},
+			"state_province": schema.StringAttribute{
+				MarkdownDescription: "State or province of the contact",
+				Optional:            true,
+			},
+			"postal_code": schema.StringAttribute{
+				MarkdownDescription: "Postal code of the contact",
+				Optional:            true,
+			},
+			"country": schema.StringAttribute{
+				MarkdownDescription: "Country of the contact, as a two-letter ISO 3166 code",
+				Optional:            true,
+			},
+			"phone": schema.StringAttribute{
+				MarkdownDescription: "Phone number of the contact",
+				Optional:            true,
+			},
+			"phone_ext": schema.StringAttribute{
+				MarkdownDescription: "Phone number extension of the contact",
+				Optional:            true,
+			},
+			"fax": schema.StringAttribute{
+				MarkdownDescription: "Fax number of the contact",
+				Optional:            true,
+			},
+			"fax_ext": schema.StringAttribute{
+				MarkdownDescription: "Fax number extension of the contact",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "Email address of the contact",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Registration contact description",
+				Optional:            true,
+			},
+			"custom_fields":     customFieldsResourceAttribute("registration contact"),
+			"custom_fields_all": customFieldsAllResourceAttribute("registration contact"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the registration contact in NetBox, used to detect modifications made outside of Terraform",
+			},
+		},
+	}
+}
+
+func (r *RegistrationContactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the registration contact currently in Netbox. It returns
+// nil without error when the registration contact does not exist anymore.
+func (r *RegistrationContactResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.RegistrationContact {
+	httpRes, err := r.client.PluginsNetboxDnsContactsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve registration contact: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsContactsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse registration contact: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, registrationContactAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
+}
+
+func (r *RegistrationContactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RegistrationContactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsContactsCreate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create registration contact: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsContactsCreateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse registration contact response: %s", err))
+		return
+	}
+	if res.JSON201 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, registrationContactAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrationContactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RegistrationContactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Internal Error", "Missing ID value")
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsContactsRetrieve(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve registration contact: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsContactsRetrieveResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse registration contact: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		if removeNotFound(ctx, httpRes, resp, "registration contact", data.ID, data.ContactID) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, registrationContactAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrationContactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RegistrationContactResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the registration contact was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The registration contact %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "registration contact", req, state.LastUpdated, remote.LastUpdated, &current, registrationContactAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsContactsUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, registrationContactAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build registration contact update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsContactsPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update registration contact: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsContactsUpdateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse registration contact response: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, registrationContactAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistrationContactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RegistrationContactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the registration contact was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "registration contact already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "registration contact", req.State, data.LastUpdated, remote.LastUpdated, &current, registrationContactAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsContactsDestroy(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy registration contact: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsContactsDestroyResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
+		return
+	}
+	if !isDeleted(ctx, res.StatusCode(), "registration contact") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy registration contact: %s", string(res.Body)))
+		return
+	}
+}
+
+// ModifyPlan applies the provider default tags, tenant and custom fields.
+func (r *RegistrationContactResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
+}
+
+func (r *RegistrationContactResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByInt64ID(ctx, req, resp)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Messages found in Netbox error responses when a transaction was rolled back
+// because of a database lock. The request had no effect and can be replayed.
+var databaseLockMessages = []string{
+	"database is locked",
+	"deadlock detected",
+	"could not obtain lock",
+	"could not serialize access",
+	"lock timeout",
+}
+
+// retryTransport replays requests failing with a transient error, waiting
+// with an exponential backoff between attempts.
+//
+// Requests that are not idempotent (POST) are only replayed when Netbox
+// cannot have processed them: the connection could not be established, the
+// request was rate limited or rejected as unavailable, or the database
+// transaction was rolled back.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+
+		res, err := t.next.RoundTrip(req)
+
+		retry, wait := t.shouldRetry(req, res, err)
+		if !retry || attempt >= t.maxRetries {
+			return res, err
+		}
+		if wait == 0 {
+			wait = t.backoff(attempt)
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = res.Status
+			drainBody(res)
+		}
+		tflog.Warn(req.Context(), "retrying Netbox API request", map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"reason":  reason,
+			"wait":    wait.String(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether the request must be replayed, and how long to
+// wait before doing so when the server asked for a specific delay.
+func (t *retryTransport) shouldRetry(req *http.Request, res *http.Response, err error) (bool, time.Duration) {
+	if req.Context().Err() != nil {
+		return false, 0
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		// the body cannot be replayed
+		return false, 0
+	}
+	idempotent := isIdempotent(req.Method)
+
+	if err != nil {
+		if isDialError(err) {
+			return true, 0
+		}
+		return idempotent && isConnectionError(err), 0
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true, parseRetryAfter(res.Header.Get("Retry-After"), t.waitMax)
+	case http.StatusServiceUnavailable:
+		return true, parseRetryAfter(res.Header.Get("Retry-After"), t.waitMax)
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent, 0
+	case http.StatusInternalServerError, http.StatusConflict:
+		return isDatabaseLockError(res), 0
+	}
+	return false, 0
+}
+
+// backoff returns the exponential delay to wait before the next attempt,
+// with some jitter so parallel requests don't retry in lockstep.
+func (t *retryTransport) backoff(attempt int) time.Duration {
+	wait := t.waitMin
+	for i := 0; i < attempt && wait < t.waitMax; i++ {
+		wait *= 2
+	}
+	if wait > t.waitMax {
+		wait = t.waitMax
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// isIdempotent reports whether a request can be replayed after Netbox may
+// have processed it. The PATCH requests of the provider only set fields to
+// absolute values, so replaying them has no further effect.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isDialError reports whether the connection to the server could not be
+// established, in which case the request was never sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsTemporary
+}
+
+func isConnectionError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// maxErrorBodyInspect is the number of bytes of an error response searched
+// for database lock messages.
+const maxErrorBodyInspect = 64 << 10
+
+func isDatabaseLockError(res *http.Response) bool {
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxErrorBodyInspect))
+	// put the body back so it can still be read by the caller
+	res.Body = prefixedBody{Reader: io.MultiReader(bytes.NewReader(body), res.Body), Closer: res.Body}
+	if err != nil {
+		return false
+	}
+	content := strings.ToLower(string(body))
+	for _, msg := range databaseLockMessages {
+		if strings.Contains(content, msg) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRetryAfter parses the value of a Retry-After header, either a number
+// of seconds or an HTTP date. It returns 0 when the header is missing or
+// invalid, and never more than limit.
+func parseRetryAfter(value string, limit time.Duration) time.Duration {
+	if value == "" {
+		return 0
+	}
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = time.Until(date)
+	}
+	if wait < 0 {
+		return 0
+	}
+	if limit > 0 && wait > limit {
+		return limit
+	}
+	return wait
+}
+
+// prefixedBody is a response body whose beginning was already read.
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+func drainBody(res *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+	res.Body.Close()
+}
+
+// timeoutTransport applies the request timeout to each attempt separately,
+// so that retries are not cut short by the time spent in previous attempts.
+type timeoutTransport struct {
+	next    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
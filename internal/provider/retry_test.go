@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method   string
+		status   int
+		body     string
+		attempts int32
+	}{
+		"get retried on bad gateway":        {http.MethodGet, http.StatusBadGateway, "", 3},
+		"post not retried on bad gateway":   {http.MethodPost, http.StatusBadGateway, "", 1},
+		"post retried on rate limiting":     {http.MethodPost, http.StatusTooManyRequests, "", 3},
+		"post retried on database lock":     {http.MethodPost, http.StatusInternalServerError, `{"detail": "deadlock detected"}`, 3},
+		"get not retried on server error":   {http.MethodGet, http.StatusInternalServerError, `{"detail": "boom"}`, 1},
+		"put not retried on client error":   {http.MethodPut, http.StatusBadRequest, "", 1},
+		"patch retried on bad gateway":      {http.MethodPatch, http.StatusBadGateway, "", 3},
+		"delete retried on gateway timeout": {http.MethodDelete, http.StatusGatewayTimeout, "", 3},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts.Add(1)
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			c, err := newHTTPClient(transportConfig{
+				RequestTimeout: time.Second,
+				MaxRetries:     2,
+				RetryWaitMin:   time.Millisecond,
+				RetryWaitMax:   time.Millisecond,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			req, err := http.NewRequest(tc.method, server.URL, strings.NewReader("{}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			res, err := c.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			res.Body.Close()
+			if res.StatusCode != tc.status {
+				t.Errorf("expected status %d, got %d", tc.status, res.StatusCode)
+			}
+			if got := attempts.Load(); got != tc.attempts {
+				t.Errorf("expected %d attempts, got %d", tc.attempts, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransportPatch(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c, err := newHTTPClient(transportConfig{
+		RequestTimeout: time.Second,
+		MaxRetries:     2,
+		RetryWaitMin:   time.Millisecond,
+		RetryWaitMax:   time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPatch, server.URL, strings.NewReader(`{"ttl":300}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("expected the retried update to succeed, got status %d", res.StatusCode)
+	}
+	// the partial update is replayed with the same body
+	if len(bodies) != 2 || bodies[1] != `{"ttl":300}` {
+		t.Errorf("expected the update to be sent twice, got %q", bodies)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if got := parseRetryAfter("5", time.Minute); got != 5*time.Second {
+		t.Errorf("expected 5s, got %s", got)
+	}
+	if got := parseRetryAfter("120", time.Minute); got != time.Minute {
+		t.Errorf("expected wait to be capped to 1m, got %s", got)
+	}
+	if got := parseRetryAfter(time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), time.Minute); got != 0 {
+		t.Errorf("expected 0 for a date in the past, got %s", got)
+	}
+	if got := parseRetryAfter("soon", time.Minute); got != 0 {
+		t.Errorf("expected 0 for an invalid value, got %s", got)
+	}
+}
+
+func TestIsDatabaseLockErrorKeepsBody(t *testing.T) {
+	for name, tc := range map[string]struct {
+		body string
+		want bool
+	}{
+		"lock message":          {`{"detail": "database is locked"}`, true},
+		"other error":           {`{"detail": "boom"}`, false},
+		"lock message too late": {strings.Repeat(" ", maxErrorBodyInspect) + "deadlock detected", false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			res := &http.Response{Body: io.NopCloser(strings.NewReader(tc.body))}
+			if got := isDatabaseLockError(res); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+			body, err := io.ReadAll(res.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(body) != tc.body {
+				t.Errorf("expected the whole body to be readable, got %d bytes of %d", len(body), len(tc.body))
+			}
+		})
+	}
+}
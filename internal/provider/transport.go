@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// transportConfig holds the HTTP settings used to build the client talking to Netbox.
+type transportConfig struct {
+	AllowInsecureHTTPS bool
+	Headers            map[string]string
+	RequestTimeout     time.Duration
+	CACertFile         string
+	CACertPEM          string
+	ClientCertFile     string
+	ClientKeyFile      string
+	ClientCertPEM      string
+	ClientKeyPEM       string
+	ProxyURL           string
+	MaxRetries         int
+	RetryWaitMin       time.Duration
+	RetryWaitMax       time.Duration
+	RequestsPerSecond  float64
+	ConcurrentRequests int
+}
+
+// newHTTPClient builds the *http.Client passed to client.WithHTTPClient.
+func newHTTPClient(cfg transportConfig) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	transport.Proxy = http.ProxyFromEnvironment
+	if cfg.ProxyURL != "" {
+		proxyURL, err := url.Parse(cfg.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		if proxyURL.Scheme != "http" && proxyURL.Scheme != "https" {
+			return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http or https", cfg.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var rt http.RoundTripper = transport
+	if len(cfg.Headers) > 0 {
+		rt = &headerTransport{headers: cfg.Headers, next: rt}
+	}
+	rt = &timeoutTransport{timeout: cfg.RequestTimeout, next: rt}
+	if cfg.RequestsPerSecond > 0 || cfg.ConcurrentRequests > 0 {
+		rt = newLimitTransport(rt, cfg.RequestsPerSecond, cfg.ConcurrentRequests)
+	}
+	if cfg.MaxRetries > 0 {
+		rt = &retryTransport{
+			maxRetries: cfg.MaxRetries,
+			waitMin:    cfg.RetryWaitMin,
+			waitMax:    cfg.RetryWaitMax,
+			next:       rt,
+		}
+	}
+
+	return &http.Client{
+		Transport: rt,
+	}, nil
+}
+
+func newTLSConfig(cfg transportConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.AllowInsecureHTTPS, //nolint:gosec // explicitly requested by the user
+	}
+
+	if cfg.CACertFile != "" && cfg.CACertPEM != "" {
+		return nil, errors.New("only one of CA certificate file and CA certificate PEM can be set")
+	}
+	caPEM := []byte(cfg.CACertPEM)
+	if cfg.CACertFile != "" {
+		var err error
+		caPEM, err = os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+		}
+	}
+	if len(caPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid certificate found in CA certificate bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certPEM, keyPEM := []byte(cfg.ClientCertPEM), []byte(cfg.ClientKeyPEM)
+	if cfg.ClientCertFile != "" {
+		if cfg.ClientCertPEM != "" {
+			return nil, errors.New("only one of client certificate file and client certificate PEM can be set")
+		}
+		var err error
+		certPEM, err = os.ReadFile(cfg.ClientCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client certificate: %w", err)
+		}
+	}
+	if cfg.ClientKeyFile != "" {
+		if cfg.ClientKeyPEM != "" {
+			return nil, errors.New("only one of client key file and client key PEM can be set")
+		}
+		var err error
+		keyPEM, err = os.ReadFile(cfg.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client key: %w", err)
+		}
+	}
+	if len(certPEM) > 0 || len(keyPEM) > 0 {
+		if len(certPEM) == 0 || len(keyPEM) == 0 {
+			return nil, errors.New("client certificate and client key must be set together")
+		}
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// headerTransport sets custom headers on every request.
+type headerTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for k, v := range t.headers {
+		req.Header.Set(k, v)
+	}
+	return t.next.RoundTrip(req)
+}
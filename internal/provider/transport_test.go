@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClientHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Test"); got != "value" {
+			t.Errorf("expected header X-Test to be %q, got %q", "value", got)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c, err := newHTTPClient(transportConfig{
+		Headers:        map[string]string{"X-Test": "value"},
+		RequestTimeout: time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+}
+
+func TestNewHTTPClientInvalidConfig(t *testing.T) {
+	for name, cfg := range map[string]transportConfig{
+		"ca file and pem":    {CACertFile: "/nonexistent", CACertPEM: "x"},
+		"invalid ca pem":     {CACertPEM: "not a certificate"},
+		"cert without key":   {ClientCertPEM: "x"},
+		"invalid proxy":      {ProxyURL: "socks5://proxy:1080"},
+		"missing ca file":    {CACertFile: "/nonexistent"},
+		"invalid client pem": {ClientCertPEM: "x", ClientKeyPEM: "y"},
+	} {
+		if _, err := newHTTPClient(cfg); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -2,15 +2,20 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 func toIntPointer(from *int64) *int {
@@ -21,14 +26,6 @@ func toIntPointer(from *int64) *int {
 	return &val
 }
 
-func int32toIntPointer(from *int32) *int {
-	if from == nil {
-		return nil
-	}
-	val := int(*from)
-	return &val
-}
-
 func booltoBoolPointer(from *bool) *bool {
 	if from == nil {
 		return nil
@@ -37,7 +34,7 @@ func booltoBoolPointer(from *bool) *bool {
 	return &val
 }
 
-func toIntListPointer(ctx context.Context, from types.List) ([]int, diag.Diagnostics) {
+func toIntSetPointer(ctx context.Context, from types.Set) ([]int, diag.Diagnostics) {
 	var values []int64
 	diags := from.ElementsAs(ctx, &values, false)
 	if diags.HasError() {
@@ -61,6 +58,13 @@ func maybeStringValue(in *string) types.String {
 	return types.StringPointerValue(in)
 }
 
+func fromStringValue(in types.String) *string {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	return in.ValueStringPointer()
+}
+
 func maybeInt64Value(in *int) types.Int64 {
 	if in == nil {
 		return types.Int64Null()
@@ -69,7 +73,7 @@ func maybeInt64Value(in *int) types.Int64 {
 }
 
 func fromInt64Value(in types.Int64) *int {
-	if in.IsNull() {
+	if in.IsNull() || in.IsUnknown() {
 		return nil
 	}
 	return toIntPointer(in.ValueInt64Pointer())
@@ -82,14 +86,13 @@ func maybeInt32Value(in *int32) types.Int32 {
 	return types.Int32Value(int32(*in))
 }
 
-func fromInt32Value(in types.Int32) *int {
-	if in.IsNull() {
+func fromInt32Value(in types.Int32) *int32 {
+	if in.IsNull() || in.IsUnknown() {
 		return nil
 	}
-	return int32toIntPointer(in.ValueInt32Pointer())
+	return in.ValueInt32Pointer()
 }
 
-
 func maybeBoolValue(in *bool) types.Bool {
 	if in == nil {
 		return types.BoolNull()
@@ -102,16 +105,150 @@ func maybeBoolValue(in *bool) types.Bool {
 }
 
 func fromBoolValue(in types.Bool) *bool {
-	if in.IsNull() {
+	if in.IsNull() || in.IsUnknown() {
 		return nil
 	}
 	return booltoBoolPointer(in.ValueBoolPointer())
 }
 
+// stringFilter returns the Netbox list filter matching a single value, or nil
+// when the value is not set.
+func stringFilter(in types.String) *[]string {
+	if v := fromStringValue(in); v != nil {
+		return &[]string{*v}
+	}
+	return nil
+}
+
+// intFilter returns the Netbox list filter matching a single ID, or nil when
+// the value is not set.
+func intFilter(in types.Int64) *[]int {
+	if v := fromInt64Value(in); v != nil {
+		return &[]int{*v}
+	}
+	return nil
+}
+
+// int32Filter returns the Netbox list filter comparing a number to a single
+// value, or nil when the value is not set.
+func int32Filter(in types.Int64) *[]int32 {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	return &[]int32{int32(in.ValueInt64())}
+}
+
+// tagsFilter returns the Netbox list filter matching the objects having all
+// the tags, or nil when the tags are not set.
+func tagsFilter(ctx context.Context, in types.Set, diags *diag.Diagnostics) *[]string {
+	if in.IsNull() || in.IsUnknown() {
+		return nil
+	}
+	var tags []string
+	diags.Append(in.ElementsAs(ctx, &tags, false)...)
+	return &tags
+}
+
+func maybeTimeValue(in *time.Time) types.String {
+	if in == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(in.Format(time.RFC3339Nano))
+}
+
 func httpError(res *http.Response, body []byte) string {
 	return fmt.Sprintf("Bad response: Status %d with content type \"%s\"\n%s", res.StatusCode, res.Header.Get("Content-Type"), string(body))
 }
 
+// addHTTPError reports an error response from Netbox. Validation errors
+// (status 400 with a JSON body like {"field": ["message"]}) are mapped to the
+// resource attributes through attributes, which maps Netbox API field names to
+// schema attribute names. Anything else is reported as a generic error.
+func addHTTPError(diags *diag.Diagnostics, res *http.Response, body []byte, attributes map[string]string) {
+	var fieldErrors map[string]interface{}
+	if res.StatusCode != http.StatusBadRequest || json.Unmarshal(body, &fieldErrors) != nil || len(fieldErrors) == 0 {
+		diags.AddError("Client Error", httpError(res, body))
+		return
+	}
+
+	fields := make([]string, 0, len(fieldErrors))
+	for field := range fieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		msg := strings.Join(flattenErrorMessages(fieldErrors[field]), "\n")
+		if field == "non_field_errors" || field == "__all__" || field == "detail" {
+			diags.AddError("Netbox validation error", msg)
+		} else if attribute, ok := attributes[field]; ok {
+			diags.AddAttributeError(path.Root(attribute), "Invalid attribute value", msg)
+		} else {
+			diags.AddError(fmt.Sprintf("Invalid value for Netbox field %q", field), msg)
+		}
+	}
+}
+
+// flattenErrorMessages collects the messages of a Netbox error value, which
+// may be a string, a list of strings or a nested structure for related objects.
+func flattenErrorMessages(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var msgs []string
+		for _, elem := range v {
+			msgs = append(msgs, flattenErrorMessages(elem)...)
+		}
+		return msgs
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var msgs []string
+		for _, key := range keys {
+			for _, msg := range flattenErrorMessages(v[key]) {
+				msgs = append(msgs, key+": "+msg)
+			}
+		}
+		return msgs
+	case nil:
+		return nil
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+// removeNotFound removes the resource from the state when Netbox answered a
+// read with 404, so that Terraform plans to recreate it. It reports whether the
+// resource was removed.
+func removeNotFound(ctx context.Context, res *http.Response, resp *resource.ReadResponse, kind string, id types.Int64, name types.String) bool {
+	if res.StatusCode != http.StatusNotFound {
+		return false
+	}
+	resp.Diagnostics.AddWarning(
+		fmt.Sprintf("%s not found", kind),
+		fmt.Sprintf("The %s %q (id %d) no longer exists in Netbox, it was probably deleted outside of Terraform. It has been removed from the state and will be recreated.", kind, name.ValueString(), id.ValueInt64()),
+	)
+	resp.State.RemoveResource(ctx)
+	return true
+}
+
+// isDeleted reports whether a delete request succeeded. An object which is
+// already gone is considered deleted.
+func isDeleted(ctx context.Context, statusCode int, kind string) bool {
+	switch statusCode {
+	case http.StatusNoContent:
+		return true
+	case http.StatusNotFound:
+		tflog.Info(ctx, fmt.Sprintf("%s already deleted from Netbox", kind))
+		return true
+	}
+	return false
+}
+
 func importByInt64ID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
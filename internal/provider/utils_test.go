@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAddHTTPError(t *testing.T) {
+	res := &http.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}}
+	body := []byte(`{"value": ["Invalid IPv4 address"], "zone": ["Object does not exist."], "non_field_errors": ["Duplicate record"], "weight": ["Too heavy"], "tags": ["Related object not found"]}`)
+
+	var diags diag.Diagnostics
+	addHTTPError(&diags, res, body, recordAPIAttributes)
+
+	if len(diags) != 5 {
+		t.Fatalf("expected 5 diagnostics, got %d: %v", len(diags), diags)
+	}
+	attributeErrors := map[string]string{}
+	for _, d := range diags {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			attributeErrors[withPath.Path().String()] = d.Detail()
+		}
+	}
+	for attr, msg := range map[string]string{
+		path.Root("value").String():   "Invalid IPv4 address",
+		path.Root("zone_id").String(): "Object does not exist.",
+		path.Root("tags").String():    "Related object not found",
+	} {
+		if attributeErrors[attr] != msg {
+			t.Errorf("expected error %q on %s, got %q", msg, attr, attributeErrors[attr])
+		}
+	}
+}
+
+func TestAddHTTPErrorFallback(t *testing.T) {
+	res := &http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}}
+
+	var diags diag.Diagnostics
+	addHTTPError(&diags, res, []byte("<html>Server Error</html>"), recordAPIAttributes)
+
+	if len(diags) != 1 || diags[0].Summary() != "Client Error" {
+		t.Fatalf("expected a single generic error, got %v", diags)
+	}
+}
+
+func TestFlattenErrorMessages(t *testing.T) {
+	got := flattenErrorMessages([]interface{}{map[string]interface{}{"name": []interface{}{"Unknown tag"}}})
+	if len(got) != 1 || got[0] != "name: Unknown tag" {
+		t.Errorf("unexpected messages %v", got)
+	}
+}
+
+func TestRemoveNotFound(t *testing.T) {
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewViewResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	for name, tc := range map[string]struct {
+		status      int
+		wantRemoved bool
+	}{
+		"deleted in Netbox": {http.StatusNotFound, true},
+		"found":             {http.StatusOK, false},
+		"server error":      {http.StatusInternalServerError, false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &resource.ReadResponse{State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+			if diags := resp.State.SetAttribute(ctx, path.Root("id"), int64(1)); diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			removed := removeNotFound(ctx, &http.Response{StatusCode: tc.status}, resp, "view", types.Int64Value(1), types.StringValue("internal"))
+			if removed != tc.wantRemoved {
+				t.Errorf("expected removed %t, got %t", tc.wantRemoved, removed)
+			}
+			if resp.State.Raw.IsNull() != tc.wantRemoved {
+				t.Errorf("expected the state to be removed: %t, got %s", tc.wantRemoved, resp.State.Raw)
+			}
+			if got := resp.Diagnostics.WarningsCount() == 1; got != tc.wantRemoved {
+				t.Errorf("expected a warning: %t, got %v", tc.wantRemoved, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestIsDeleted(t *testing.T) {
+	for status, want := range map[int]bool{
+		http.StatusNoContent:           true,
+		http.StatusNotFound:            true,
+		http.StatusConflict:            false,
+		http.StatusInternalServerError: false,
+	} {
+		if got := isDeleted(context.Background(), status, "view"); got != want {
+			t.Errorf("status %d: expected %t, got %t", status, want, got)
+		}
+	}
+}
@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &ViewDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ViewDataSource{}
 
 func NewViewDataSource() datasource.DataSource {
 	return &ViewDataSource{}
@@ -23,15 +26,35 @@ type ViewDataSource struct {
 }
 
 type ViewDataSourceModel struct {
-	ID            types.Int64        `tfsdk:"id"`
-	Name          types.String       `tfsdk:"name"`
-	Description   types.String       `tfsdk:"description"`
+	ID              types.Int64   `tfsdk:"id"`
+	Name            types.String  `tfsdk:"name"`
+	Description     types.String  `tfsdk:"description"`
+	DefaultView     types.Bool    `tfsdk:"default_view"`
+	PrefixIDs       []types.Int64 `tfsdk:"prefix_ids"`
+	IPAddressFilter types.String  `tfsdk:"ip_address_filter"`
+	Tags            types.Set     `tfsdk:"tags"`
+	TenantID        types.Int64   `tfsdk:"tenant_id"`
+	CustomFields    types.Map     `tfsdk:"custom_fields"`
 }
 
-func (m *ViewDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.View, diags diag.Diagnostics) {
+func (m *ViewDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.View, diags *diag.Diagnostics) {
 	m.ID = maybeInt64Value(resp.Id)
 	m.Name = maybeStringValue(&resp.Name)
 	m.Description = maybeStringValue(resp.Description)
+	m.DefaultView = maybeBoolValue(resp.DefaultView)
+	m.PrefixIDs = []types.Int64{}
+	if resp.Prefixes != nil {
+		for _, element := range *resp.Prefixes {
+			m.PrefixIDs = append(m.PrefixIDs, maybeInt64Value(element.Id))
+		}
+	}
+	m.IPAddressFilter = maybeJSONValue(types.StringNull(), resp.IpAddressFilter)
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
 }
 
 func (d *ViewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -40,17 +63,35 @@ func (d *ViewDataSource) Metadata(ctx context.Context, req datasource.MetadataRe
 
 var viewDataSchema = map[string]schema.Attribute{
 	"id": schema.Int64Attribute{
-		MarkdownDescription: "ID of the resource in Netbox to use for lookup",
-		Required:            true,
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
 	},
 	"name": schema.StringAttribute{
-		MarkdownDescription: "view name",
-		Computed: true,
+		MarkdownDescription: "View name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
 	},
 	"description": schema.StringAttribute{
-		Computed: true,
+		Computed:            true,
 		MarkdownDescription: `View description`,
 	},
+	"default_view": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: `True if the view is the default view of NetBox DNS`,
+	},
+	"prefix_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: `IDs of the IPAM prefixes assigned to the view`,
+	},
+	"ip_address_filter": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `JSON encoded filter selecting the IPAM IP addresses for which NetBox DNS generates records in the view`,
+	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
 }
 
 func (d *ViewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -69,26 +110,65 @@ func (d *ViewDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-
-	httpRes, err := d.client.PluginsNetboxDnsViewsRetrieve(ctx, int(data.ID.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve view: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	res, err := client.ParsePluginsNetboxDnsViewsRetrieveResponse(httpRes)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse view: %s", err))
-		return
+
+	var view *client.View
+	if !data.ID.IsNull() {
+		view = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		view = d.lookup(ctx, data, &resp.Diagnostics)
 	}
-	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, view, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *ViewDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.View {
+	httpRes, err := d.client.PluginsNetboxDnsViewsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve view: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsViewsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse view: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookup finds the view with the configured name, which must match exactly
+// one view.
+func (d *ViewDataSource) lookup(ctx context.Context, data ViewDataSourceModel, diags *diag.Diagnostics) *client.View {
+	params := client.PluginsNetboxDnsViewsListParams{
+		Name: &[]string{data.Name.ValueString()},
+	}
+	criteria := fmt.Sprintf("named %q", data.Name.ValueString())
+	views := listViews(ctx, d.client, params, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return singleResult(views, path.Root("name"), "view", "views", criteria, diags)
+}
+
+func (d *ViewDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
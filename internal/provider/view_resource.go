@@ -6,24 +6,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ViewResource{}
 var _ resource.ResourceWithImportState = &ViewResource{}
+var _ resource.ResourceWithModifyPlan = &ViewResource{}
 
 func NewViewResource() resource.Resource {
 	return &ViewResource{}
@@ -31,28 +34,87 @@ func NewViewResource() resource.Resource {
 
 // ViewResource defines the resource implementation.
 type ViewResource struct {
-	client *client.Client
+	client   *client.Client
+	provider *configuredProvider
 }
 
 // ViewResourceModel describes the resource data model.
 type ViewResourceModel struct {
-	ID            types.Int64        `tfsdk:"id"`
-	Name          types.String       `tfsdk:"name"`
-	Description   types.String       `tfsdk:"description"`
+	ID              types.Int64  `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	DefaultView     types.Bool   `tfsdk:"default_view"`
+	PrefixIDs       types.Set    `tfsdk:"prefix_ids"`
+	IPAddressFilter types.String `tfsdk:"ip_address_filter"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	TenantID        types.Int64  `tfsdk:"tenant_id"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+}
+
+// viewAPIAttributes maps Netbox API fields to view resource attributes.
+var viewAPIAttributes = map[string]string{
+	"name":              "name",
+	"description":       "description",
+	"prefixes":          "prefix_ids",
+	"ip_address_filter": "ip_address_filter",
+	"tags":              "tags",
+	"tenant":            "tenant_id",
+	"custom_fields":     "custom_fields",
 }
 
-func (m *ViewResourceModel) ToAPIModel(ctx context.Context, diags diag.Diagnostics) client.ViewRequest {
+func (m *ViewResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.ViewRequest {
 	p := client.ViewRequest{}
 	p.Name = *m.Name.ValueStringPointer()
 	p.Description = m.Description.ValueStringPointer()
+	if !m.PrefixIDs.IsNull() && !m.PrefixIDs.IsUnknown() {
+		prefixes, ds := toIntSetPointer(ctx, m.PrefixIDs)
+		for _, d := range ds {
+			diags.Append(diag.WithPath(path.Root("prefix_ids"), d))
+		}
+		p.Prefixes = &prefixes
+	}
+	p.IpAddressFilter = fromJSONValue(m.IPAddressFilter)
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
 
 	return p
 }
 
-func (m *ViewResourceModel) FillFromAPIModel(ctx context.Context, resp *client.View, diags diag.Diagnostics) {
-        m.ID = maybeInt64Value(resp.Id)
-        m.Name = maybeStringValue(&resp.Name)
-        m.Description = maybeStringValue(resp.Description)
+func (m *ViewResourceModel) FillFromAPIModel(ctx context.Context, resp *client.View, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	m.DefaultView = maybeBoolValue(resp.DefaultView)
+
+	// api resp.Prefixes is a []BriefPrefix
+	prefixes := []int64{}
+	if resp.Prefixes != nil {
+		for _, element := range *resp.Prefixes {
+			if element.Id != nil {
+				prefixes = append(prefixes, int64(*element.Id))
+			}
+		}
+	}
+	var ds diag.Diagnostics
+	m.PrefixIDs, ds = types.SetValueFrom(ctx, types.Int64Type, prefixes)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("prefix_ids"), d))
+	}
+
+	m.IPAddressFilter = maybeJSONValue(m.IPAddressFilter, resp.IpAddressFilter)
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
 }
 
 func (r *ViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -80,12 +142,69 @@ func (r *ViewResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				MarkdownDescription: "View description",
 				Optional:            true,
 			},
+			"default_view": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "True if the view is the default view of NetBox DNS. This attribute is read-only: the default view is chosen in the NetBox user interface, the API does not allow to change it.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prefix_ids": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IDs of the IPAM prefixes assigned to the view. NetBox DNS generates address and PTR records in this view for the IP addresses of these prefixes. When not set, the prefixes assigned in NetBox are left unchanged, set it to an empty set to remove them.",
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_address_filter": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded filter selecting the IPAM IP addresses for which NetBox DNS generates records in the view, for example `jsonencode({ status = \"active\" })`",
+				Validators: []validator.String{
+					jsonValidator{},
+				},
+			},
+			"tags":              tagsResourceAttribute("view"),
+			"tags_all":          tagsAllResourceAttribute("view"),
+			"tenant_id":         tenantIDResourceAttribute("view"),
+			"custom_fields":     customFieldsResourceAttribute("view"),
+			"custom_fields_all": customFieldsAllResourceAttribute("view"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the view in NetBox, used to detect modifications made outside of Terraform",
+			},
 		},
 	}
 }
 
 func (r *ViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = configureResourceClient(req, resp)
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the view currently in Netbox. It returns nil without
+// error when the view does not exist anymore.
+func (r *ViewResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.View {
+	httpRes, err := r.client.PluginsNetboxDnsViewsRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve view: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsViewsRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse view: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, viewAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
 }
 
 func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -97,7 +216,7 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -113,15 +232,17 @@ func (r *ViewResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 	if res.JSON201 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, viewAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON201, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.provider.forgetNames("view", data.Name)
+
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a resource")
 
@@ -155,11 +276,14 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		if removeNotFound(ctx, httpRes, resp, "view", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, viewAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -168,18 +292,50 @@ func (r *ViewResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ViewResourceModel
+	var data, state ViewResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the view was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The view %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "view", req, state.LastUpdated, remote.LastUpdated, &current, viewAPIAttributes, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpRes, err := r.client.PluginsNetboxDnsViewsUpdate(ctx, int(data.ID.ValueInt64()), params)
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsViewsUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, viewAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build view update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsViewsPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update view: %s", err))
 		return
@@ -190,15 +346,17 @@ func (r *ViewResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, viewAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.provider.forgetNames("view", state.Name, data.Name)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -212,6 +370,22 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	// Check the view was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "view already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "view", req.State, data.LastUpdated, remote.LastUpdated, &current, viewAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	httpRes, err := r.client.PluginsNetboxDnsViewsDestroy(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy view: %s", err))
@@ -222,10 +396,16 @@ func (r *ViewResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
 		return
 	}
-	if res.StatusCode() != http.StatusNoContent {
+	if !isDeleted(ctx, res.StatusCode(), "view") {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy view: %s", string(res.Body)))
 		return
 	}
+	r.provider.forgetNames("view", data.Name)
+}
+
+// ModifyPlan applies the provider default tags, tenant and custom fields.
+func (r *ViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
 }
 
 func (r *ViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ViewsDataSource{}
+
+func NewViewsDataSource() datasource.DataSource {
+	return &ViewsDataSource{}
+}
+
+type ViewsDataSource struct {
+	client *client.Client
+}
+
+type ViewsDataSourceModel struct {
+	Name           types.String          `tfsdk:"name"`
+	NameContains   types.String          `tfsdk:"name_contains"`
+	NameStartsWith types.String          `tfsdk:"name_starts_with"`
+	NameNot        types.String          `tfsdk:"name_not"`
+	DefaultView    types.Bool            `tfsdk:"default_view"`
+	Tags           types.Set             `tfsdk:"tags"`
+	TenantID       types.Int64           `tfsdk:"tenant_id"`
+	Views          []ViewDataSourceModel `tfsdk:"views"`
+}
+
+// ToListParams returns the Netbox list filters matching the configured filters.
+func (m *ViewsDataSourceModel) ToListParams(ctx context.Context, diags *diag.Diagnostics) client.PluginsNetboxDnsViewsListParams {
+	params := client.PluginsNetboxDnsViewsListParams{}
+	params.Name = stringFilter(m.Name)
+	params.NameIc = stringFilter(m.NameContains)
+	params.NameIsw = stringFilter(m.NameStartsWith)
+	params.NameN = stringFilter(m.NameNot)
+	params.DefaultView = fromBoolValue(m.DefaultView)
+	params.Tag = tagsFilter(ctx, m.Tags, diags)
+	params.TenantId = intFilter(m.TenantID)
+	return params
+}
+
+func (d *ViewsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_views"
+}
+
+func (d *ViewsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNS Views data source, returning the views matching all the filters set",
+		Attributes: map[string]schema.Attribute{
+			"name":             filterAttribute("Name of the views"),
+			"name_contains":    filterAttribute("Part of the name of the views, case insensitive"),
+			"name_starts_with": filterAttribute("Start of the name of the views, case insensitive"),
+			"name_not":         filterAttribute("Name the views must not have"),
+			"default_view": schema.BoolAttribute{
+				MarkdownDescription: "True to return only the default view, false to return only the other views",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Slugs of tags the views must all have",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tenant_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the tenant of the views",
+				Optional:            true,
+			},
+			"views": schema.ListNestedAttribute{
+				MarkdownDescription: "Views matching the filters, with the attributes of the `netboxdns_view` data source",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAttributes(viewDataSchema),
+				},
+			},
+		},
+	}
+}
+
+func (d *ViewsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *ViewsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ViewsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToListParams(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	views := listViews(ctx, d.client, params, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Views = make([]ViewDataSourceModel, len(views))
+	for i := range views {
+		data.Views[i].FillFromAPIModel(ctx, &views[i], &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
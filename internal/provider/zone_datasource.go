@@ -4,15 +4,18 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ datasource.DataSource = &ZoneDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ZoneDataSource{}
 
 func NewZoneDataSource() datasource.DataSource {
 	return &ZoneDataSource{}
@@ -23,47 +26,108 @@ type ZoneDataSource struct {
 }
 
 type ZoneDataSourceModel struct {
-	ID               types.Int64        `tfsdk:"id"`
-	View             *NestedView        `tfsdk:"view"`
-	Name             types.String       `tfsdk:"name"`
-	Status           types.String       `tfsdk:"status"`
-	NameserversIDs   []types.Int64      `tfsdk:"nameserver_ids"`
-	DefaultTTL       types.Int32        `tfsdk:"default_ttl"`
-	SOATTL           types.Int32        `tfsdk:"soa_ttl"`
-	SOAMName         *NestedNameserver  `tfsdk:"soa_mname"`
-	SOARName         types.String       `tfsdk:"soa_rname"`
-	SOASerial        types.Int32        `tfsdk:"soa_serial"`
-	SOARefresh       types.Int32        `tfsdk:"soa_refresh"`
-	SOARetry         types.Int32        `tfsdk:"soa_retry"`
-	SOAExpire        types.Int32        `tfsdk:"soa_expire"`
-	SOASerialAuto    types.Bool         `tfsdk:"soa_serial_auto"`
-	Description      types.String       `tfsdk:"description"`
+	ID            types.Int64       `tfsdk:"id"`
+	View          *NestedView       `tfsdk:"view"`
+	ViewName      types.String      `tfsdk:"view_name"`
+	Name          types.String      `tfsdk:"name"`
+	Status        types.String      `tfsdk:"status"`
+	NameserverIDs []types.Int64     `tfsdk:"nameserver_ids"`
+	DefaultTTL    types.Int32       `tfsdk:"default_ttl"`
+	SOATTL        types.Int32       `tfsdk:"soa_ttl"`
+	SOAMName      *NestedNameserver `tfsdk:"soa_mname"`
+	SOARName      types.String      `tfsdk:"soa_rname"`
+	SOASerial     types.Int32       `tfsdk:"soa_serial"`
+	SOAMinimum    types.Int32       `tfsdk:"soa_minimum"`
+	SOARefresh    types.Int32       `tfsdk:"soa_refresh"`
+	SOARetry      types.Int32       `tfsdk:"soa_retry"`
+	SOAExpire     types.Int32       `tfsdk:"soa_expire"`
+	SOASerialAuto types.Bool        `tfsdk:"soa_serial_auto"`
+	Description   types.String      `tfsdk:"description"`
+
+	Registrar        *NestedRegistrar           `tfsdk:"registrar"`
+	Registrant       *NestedRegistrationContact `tfsdk:"registrant"`
+	AdminC           *NestedRegistrationContact `tfsdk:"admin_c"`
+	TechC            *NestedRegistrationContact `tfsdk:"tech_c"`
+	BillingC         *NestedRegistrationContact `tfsdk:"billing_c"`
+	RegistryDomainID types.String               `tfsdk:"registry_domain_id"`
+	ExpirationDate   types.String               `tfsdk:"expiration_date"`
+	DomainStatus     types.String               `tfsdk:"domain_status"`
+
+	DNSSECPolicy  *NestedDNSSECPolicy `tfsdk:"dnssec_policy"`
+	InlineSigning types.Bool          `tfsdk:"inline_signing"`
+	DNSSECEnabled types.Bool          `tfsdk:"dnssec_enabled"`
+
+	RFC2317Prefix        types.String  `tfsdk:"rfc2317_prefix"`
+	RFC2317ParentManaged types.Bool    `tfsdk:"rfc2317_parent_managed"`
+	RFC2317ParentZone    *NestedZone   `tfsdk:"rfc2317_parent_zone"`
+	RFC2317ChildZoneIDs  []types.Int64 `tfsdk:"rfc2317_child_zone_ids"`
+	Tags                 types.Set     `tfsdk:"tags"`
+	TenantID             types.Int64   `tfsdk:"tenant_id"`
+	CustomFields         types.Map     `tfsdk:"custom_fields"`
 }
 
-func (m *ZoneDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.Zone, diags diag.Diagnostics) {
+func (m *ZoneDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.Zone, diags *diag.Diagnostics) {
 	m.ID = maybeInt64Value(resp.Id)
 	m.View = NestedViewFromAPI(resp.View)
+	m.ViewName = types.StringNull()
+	if resp.View != nil {
+		m.ViewName = types.StringValue(resp.View.Name)
+	}
 	m.Name = maybeStringValue(&resp.Name)
 	m.Status = maybeStringValue((*string)(resp.Status))
 
 	// Zone struct, field Nameservers *[]BriefNameServer
 	// type BriefNameServer struct { Id          *int
+	m.NameserverIDs = []types.Int64{}
 	if resp.Nameservers != nil {
 		for _, element := range *resp.Nameservers {
-			m.NameserversIDs = append(m.NameserversIDs, types.Int64Value( int64(*(element.Id)) ) )
+			m.NameserverIDs = append(m.NameserverIDs, maybeInt64Value(element.Id))
 		}
 	}
 
-	m.DefaultTTL  = maybeInt32Value(resp.DefaultTtl)
-	m.SOATTL  = maybeInt32Value(resp.SoaTtl)
+	m.DefaultTTL = maybeInt32Value(resp.DefaultTtl)
+	m.SOATTL = maybeInt32Value(resp.SoaTtl)
 	m.SOAMName = NestedNameserverFromAPI(resp.SoaMname)
 	m.SOARName = maybeStringValue(resp.SoaRname)
-	m.SOASerial  = maybeInt32Value(resp.SoaSerial)
-	m.SOARefresh  = maybeInt32Value(resp.SoaRefresh)
-	m.SOARetry  = maybeInt32Value(resp.SoaRetry)
-	m.SOAExpire  = maybeInt32Value(resp.SoaExpire)
+	m.SOASerial = maybeInt32Value(resp.SoaSerial)
+	m.SOAMinimum = maybeInt32Value(resp.SoaMinimum)
+	m.SOARefresh = maybeInt32Value(resp.SoaRefresh)
+	m.SOARetry = maybeInt32Value(resp.SoaRetry)
+	m.SOAExpire = maybeInt32Value(resp.SoaExpire)
 	m.SOASerialAuto = maybeBoolValue(resp.SoaSerialAuto)
 	m.Description = maybeStringValue(resp.Description)
+
+	m.Registrar = NestedRegistrarFromAPI(resp.Registrar)
+	m.Registrant = NestedRegistrationContactFromAPI(resp.Registrant)
+	m.AdminC = NestedRegistrationContactFromAPI(resp.AdminC)
+	m.TechC = NestedRegistrationContactFromAPI(resp.TechC)
+	m.BillingC = NestedRegistrationContactFromAPI(resp.BillingC)
+	m.RegistryDomainID = maybeStringValue(resp.RegistryDomainId)
+	m.ExpirationDate = types.StringNull()
+	if resp.ExpirationDate != nil {
+		m.ExpirationDate = types.StringValue(resp.ExpirationDate.String())
+	}
+	m.DomainStatus = maybeStringValue((*string)(resp.DomainStatus))
+
+	m.DNSSECPolicy = NestedDNSSECPolicyFromAPI(resp.DnssecPolicy)
+	m.InlineSigning = maybeBoolValue(resp.InlineSigning)
+	m.DNSSECEnabled = types.BoolValue(resp.DnssecPolicy != nil && resp.DnssecPolicy.Status != nil && *resp.DnssecPolicy.Status == client.BriefDNSSECPolicyStatusActive)
+
+	m.RFC2317Prefix = maybeStringValue(resp.Rfc2317Prefix)
+	m.RFC2317ParentManaged = maybeBoolValue(resp.Rfc2317ParentManaged)
+	m.RFC2317ParentZone = NestedZoneFromAPI(resp.Rfc2317ParentZone)
+	m.RFC2317ChildZoneIDs = []types.Int64{}
+	if resp.Rfc2317ChildZones != nil {
+		for _, element := range *resp.Rfc2317ChildZones {
+			m.RFC2317ChildZoneIDs = append(m.RFC2317ChildZoneIDs, maybeInt64Value(element.Id))
+		}
+	}
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
 }
 
 func (d *ZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -72,69 +136,150 @@ func (d *ZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRe
 
 var zoneDataSchema = map[string]schema.Attribute{
 	"id": schema.Int64Attribute{
-		MarkdownDescription: "ID of the resource in Netbox to use for lookup",
-		Required:            true,
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
 	},
 	"view": schema.SingleNestedAttribute{
 		MarkdownDescription: "DNS View",
-		Computed:   true,
-		Attributes: (*NestedView)(nil).SchemaAttributes(),
+		Computed:            true,
+		Attributes:          (*NestedView)(nil).SchemaAttributes(),
+	},
+	"view_name": schema.StringAttribute{
+		MarkdownDescription: "Name of the view of the zone, to look the zone up by `name` in a single view",
+		Optional:            true,
+		Computed:            true,
 	},
 	"name": schema.StringAttribute{
-		MarkdownDescription: "zone name",
-		Computed: true,
+		MarkdownDescription: "Zone name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
 	},
 	"status": schema.StringAttribute{
 		Computed:            true,
-		MarkdownDescription: `"active" or "inactive"`,
+		MarkdownDescription: `one of "active", "deprecated", "dynamic", "parked" or "reserved"`,
 	},
-        "nameserver_ids": schema.ListAttribute{
-                ElementType: types.Int64Type,
-                Computed:    true,
-		MarkdownDescription: `List of nameservers`,
-        },
-	"defaul_ttl": schema.Int64Attribute{
-		Computed: true,
-		MarkdownDescription: `Default TTL`,
+	"nameserver_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: `IDs of the nameservers of the zone`,
 	},
-	"soa_ttl": schema.Int64Attribute{
-		Computed: true,
-		MarkdownDescription: `Default TTL`,
+	"default_ttl": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: `Default TTL for records in the zone`,
+	},
+	"soa_ttl": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: `TTL of the SOA record`,
 	},
 	"soa_mname": schema.SingleNestedAttribute{
 		MarkdownDescription: "Primary nameserver",
-		Computed:   true,
-		Attributes: (*NestedNameserver)(nil).SchemaAttributes(),
+		Computed:            true,
+		Attributes:          (*NestedNameserver)(nil).SchemaAttributes(),
 	},
 	"soa_rname": schema.StringAttribute{
 		Computed:            true,
 		MarkdownDescription: `zone administrator email address`,
 	},
-
-	"soa_serial": schema.Int64Attribute{
-		Computed: true,
-		MarkdownDescription: `Default TTL`,
+	"soa_serial": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: `SOA serial number`,
 	},
-	"soa_refresh": schema.Int64Attribute{
-		Computed: true,
-		MarkdownDescription: `Default TTL`,
+	"soa_minimum": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: `SOA minimum TTL (negative caching)`,
 	},
-	"soa_retry": schema.Int64Attribute{
-		Computed: true,
-		MarkdownDescription: `Default TTL`,
+	"soa_refresh": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: `SOA refresh interval`,
 	},
-	"soa_expire": schema.Int64Attribute{
-		Computed: true,
-		MarkdownDescription: `Default TTL`,
+	"soa_retry": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: `SOA retry interval`,
+	},
+	"soa_expire": schema.Int32Attribute{
+		Computed:            true,
+		MarkdownDescription: `SOA expire time`,
 	},
 	"soa_serial_auto": schema.BoolAttribute{
-		Computed: true,
+		Computed:            true,
 		MarkdownDescription: `True if serial is generated automaticaly`,
 	},
 	"description": schema.StringAttribute{
-		Computed: true,
+		Computed:            true,
 		MarkdownDescription: `Zone description`,
 	},
+	"registrar": schema.SingleNestedAttribute{
+		MarkdownDescription: "Registrar the domain is registered with",
+		Computed:            true,
+		Attributes:          (*NestedRegistrar)(nil).SchemaAttributes(),
+	},
+	"registrant": schema.SingleNestedAttribute{
+		MarkdownDescription: "Registration contact of the domain registrant",
+		Computed:            true,
+		Attributes:          (*NestedRegistrationContact)(nil).SchemaAttributes(),
+	},
+	"admin_c": schema.SingleNestedAttribute{
+		MarkdownDescription: "Administrative registration contact of the domain",
+		Computed:            true,
+		Attributes:          (*NestedRegistrationContact)(nil).SchemaAttributes(),
+	},
+	"tech_c": schema.SingleNestedAttribute{
+		MarkdownDescription: "Technical registration contact of the domain",
+		Computed:            true,
+		Attributes:          (*NestedRegistrationContact)(nil).SchemaAttributes(),
+	},
+	"billing_c": schema.SingleNestedAttribute{
+		MarkdownDescription: "Billing registration contact of the domain",
+		Computed:            true,
+		Attributes:          (*NestedRegistrationContact)(nil).SchemaAttributes(),
+	},
+	"registry_domain_id": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `ID of the domain in the registry`,
+	},
+	"expiration_date": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `Expiration date of the domain registration (YYYY-MM-DD)`,
+	},
+	"domain_status": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `EPP status of the domain in the registry`,
+	},
+	"dnssec_policy": schema.SingleNestedAttribute{
+		MarkdownDescription: "DNSSEC policy used to sign the zone",
+		Computed:            true,
+		Attributes:          (*NestedDNSSECPolicy)(nil).SchemaAttributes(),
+	},
+	"inline_signing": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: `True if the zone is signed inline by the nameservers`,
+	},
+	"dnssec_enabled": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: `True if the zone is signed with an active DNSSEC policy`,
+	},
+	"rfc2317_prefix": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: `IPv4 prefix delegated with the zone following RFC 2317`,
+	},
+	"rfc2317_parent_managed": schema.BoolAttribute{
+		Computed:            true,
+		MarkdownDescription: `True if the parent reverse zone of the RFC 2317 zone is managed in NetBox`,
+	},
+	"rfc2317_parent_zone": schema.SingleNestedAttribute{
+		MarkdownDescription: "Parent reverse zone holding the CNAME records of the RFC 2317 zone",
+		Computed:            true,
+		Attributes:          (*NestedZone)(nil).SchemaAttributes(),
+	},
+	"rfc2317_child_zone_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: `IDs of the RFC 2317 zones delegated from the zone`,
+	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
 }
 
 func (d *ZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -153,26 +298,73 @@ func (d *ZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
-
-	httpRes, err := d.client.PluginsNetboxDnsZonesRetrieve(ctx, int(data.ID.ValueInt64()))
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve zone: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	res, err := client.ParsePluginsNetboxDnsZonesRetrieveResponse(httpRes)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse zone: %s", err))
-		return
+
+	var zone *client.Zone
+	if !data.ID.IsNull() {
+		zone = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		zone = d.lookup(ctx, data, &resp.Diagnostics)
 	}
-	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, zone, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (d *ZoneDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.Zone {
+	httpRes, err := d.client.PluginsNetboxDnsZonesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve zone: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsZonesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse zone: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookup finds the zone with the configured name, in the configured view if
+// any, which must match exactly one zone.
+func (d *ZoneDataSource) lookup(ctx context.Context, data ZoneDataSourceModel, diags *diag.Diagnostics) *client.Zone {
+	params := client.PluginsNetboxDnsZonesListParams{
+		Name: &[]string{data.Name.ValueString()},
+	}
+	criteria := fmt.Sprintf("named %q", data.Name.ValueString())
+	if view := fromStringValue(data.ViewName); view != nil {
+		params.View = &[]string{*view}
+		criteria += fmt.Sprintf(" in view %q", *view)
+	}
+	zones := listZones(ctx, d.client, params, diags)
+	if diags.HasError() {
+		return nil
+	}
+	return singleResult(zones, path.Root("name"), "zone", "zones", criteria, diags)
+}
+
+func (d *ZoneDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+		datasourcevalidator.Conflicting(
+			path.MatchRoot("id"),
+			path.MatchRoot("view_name"),
+		),
+	}
+}
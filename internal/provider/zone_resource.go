@@ -6,23 +6,35 @@ package provider
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"net/netip"
 
-	"github.com/jean1/terraform-provider-netbox-dns/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ZoneResource{}
 var _ resource.ResourceWithImportState = &ZoneResource{}
+var _ resource.ResourceWithModifyPlan = &ZoneResource{}
 
 func NewZoneResource() resource.Resource {
 	return &ZoneResource{}
@@ -30,90 +42,302 @@ func NewZoneResource() resource.Resource {
 
 // ZoneResource defines the resource implementation.
 type ZoneResource struct {
-	client *client.Client
+	client   *client.Client
+	provider *configuredProvider
 }
 
 // ZoneResourceModel describes the resource data model.
 type ZoneResourceModel struct {
-	ID             types.Int64        `tfsdk:"id"`
-	ViewID         types.Int64        `tfsdk:"view"`
-	Name           types.String       `tfsdk:"name"`
-	Status         types.String       `tfsdk:"status"`
-	Nameservers    types.List         `tfsdk:"nameservers"`
-	DefaultTTL     types.Int32        `tfsdk:"default_ttl"`
-	SOATTL         types.Int32        `tfsdk:"soa_ttl"`
-	SOAMNameID     types.Int32        `tfsdk:"soa_mname"`
-	SOARName       types.String       `tfsdk:"soa_rname"`
-	SOASerial      types.Int32        `tfsdk:"soa_serial"`
-	SOAMinimum     types.Int32        `tfsdk:"soa_minimum"`
-	SOARefresh     types.Int32        `tfsdk:"soa_refresh"`
-	SOARetry       types.Int32        `tfsdk:"soa_retry"`
-	SOAExpire      types.Int32        `tfsdk:"soa_expire"`
-	SOASerialAuto  types.Bool       `tfsdk:"soa_serial_auto"`
-	Description    types.String       `tfsdk:"description"`
+	ID              types.Int64  `tfsdk:"id"`
+	ViewID          types.Int64  `tfsdk:"view_id"`
+	ViewName        types.String `tfsdk:"view_name"`
+	Name            types.String `tfsdk:"name"`
+	Status          types.String `tfsdk:"status"`
+	NameserverIDs   types.Set    `tfsdk:"nameserver_ids"`
+	NameserverNames types.Set    `tfsdk:"nameserver_names"`
+	DefaultTTL      types.Int32  `tfsdk:"default_ttl"`
+	SOATTL          types.Int32  `tfsdk:"soa_ttl"`
+	SOAMNameID      types.Int64  `tfsdk:"soa_mname_id"`
+	SOAMNameName    types.String `tfsdk:"soa_mname_name"`
+	SOARName        types.String `tfsdk:"soa_rname"`
+	SOASerial       types.Int32  `tfsdk:"soa_serial"`
+	SOAMinimum      types.Int32  `tfsdk:"soa_minimum"`
+	SOARefresh      types.Int32  `tfsdk:"soa_refresh"`
+	SOARetry        types.Int32  `tfsdk:"soa_retry"`
+	SOAExpire       types.Int32  `tfsdk:"soa_expire"`
+	SOASerialAuto   types.Bool   `tfsdk:"soa_serial_auto"`
+	Description     types.String `tfsdk:"description"`
+	TemplateID      types.Int64  `tfsdk:"template_id"`
+	Tags            types.Set    `tfsdk:"tags"`
+	TagsAll         types.Set    `tfsdk:"tags_all"`
+	TenantID        types.Int64  `tfsdk:"tenant_id"`
+	CustomFields    types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+
+	RegistrarID      types.Int64  `tfsdk:"registrar_id"`
+	RegistrantID     types.Int64  `tfsdk:"registrant_id"`
+	AdminCID         types.Int64  `tfsdk:"admin_c_id"`
+	TechCID          types.Int64  `tfsdk:"tech_c_id"`
+	BillingCID       types.Int64  `tfsdk:"billing_c_id"`
+	RegistryDomainID types.String `tfsdk:"registry_domain_id"`
+	ExpirationDate   types.String `tfsdk:"expiration_date"`
+	DomainStatus     types.String `tfsdk:"domain_status"`
+
+	DNSSECPolicyID   types.Int64  `tfsdk:"dnssec_policy_id"`
+	DNSSECPolicyName types.String `tfsdk:"dnssec_policy_name"`
+	InlineSigning    types.Bool   `tfsdk:"inline_signing"`
+
+	RFC2317Prefix        types.String `tfsdk:"rfc2317_prefix"`
+	RFC2317ParentManaged types.Bool   `tfsdk:"rfc2317_parent_managed"`
+	RFC2317ParentZoneID  types.Int64  `tfsdk:"rfc2317_parent_zone_id"`
+	RFC2317ChildZoneIDs  types.Set    `tfsdk:"rfc2317_child_zone_ids"`
+
+	ReapplyTemplateOnUpdate types.Bool `tfsdk:"reapply_template_on_update"`
+}
+
+// zoneAPIAttributes maps Netbox API fields to zone resource attributes.
+var zoneAPIAttributes = map[string]string{
+	"view":            "view_id",
+	"name":            "name",
+	"status":          "status",
+	"nameservers":     "nameserver_ids",
+	"default_ttl":     "default_ttl",
+	"soa_ttl":         "soa_ttl",
+	"soa_mname":       "soa_mname_id",
+	"soa_rname":       "soa_rname",
+	"soa_serial":      "soa_serial",
+	"soa_minimum":     "soa_minimum",
+	"soa_refresh":     "soa_refresh",
+	"soa_retry":       "soa_retry",
+	"soa_expire":      "soa_expire",
+	"soa_serial_auto": "soa_serial_auto",
+	"description":     "description",
+	"registrar":       "registrar_id",
+	"registrant":      "registrant_id",
+	"admin_c":         "admin_c_id",
+	"tech_c":          "tech_c_id",
+	"billing_c":       "billing_c_id",
+	"dnssec_policy":   "dnssec_policy_id",
+	"inline_signing":  "inline_signing",
+
+	"rfc2317_prefix":         "rfc2317_prefix",
+	"rfc2317_parent_managed": "rfc2317_parent_managed",
+	"tags":                   "tags",
+	"tenant":                 "tenant_id",
+	"custom_fields":          "custom_fields",
+}
+
+// zoneTemplateAttributes maps the Netbox API fields set from the zone template
+// to zone resource attributes. Netbox only applies the template to the fields
+// missing from the request, so that the configured values take precedence.
+var zoneTemplateAttributes = map[string]string{
+	"nameservers": "nameserver_ids",
+	"soa_mname":   "soa_mname_id",
+	"soa_rname":   "soa_rname",
+	"registrar":   "registrar_id",
+	"registrant":  "registrant_id",
+	"admin_c":     "admin_c_id",
+	"tech_c":      "tech_c_id",
+	"billing_c":   "billing_c_id",
+	"tenant":      "tenant_id",
+
+	"dnssec_policy": "dnssec_policy_id",
+}
+
+// conflictAttributes returns the attributes compared with Netbox to detect
+// modifications made outside of Terraform. The serial is left out when it is
+// generated, as Netbox bumps it whenever a record of the zone changes.
+func (m *ZoneResourceModel) conflictAttributes() map[string]string {
+	if !m.SOASerialAuto.ValueBool() {
+		return zoneAPIAttributes
+	}
+	attributes := make(map[string]string, len(zoneAPIAttributes))
+	for field, attribute := range zoneAPIAttributes {
+		if field != "soa_serial" {
+			attributes[field] = attribute
+		}
+	}
+	return attributes
 }
 
 // Write to API
-func (m *ZoneResourceModel) ToAPIModel(ctx context.Context, diags diag.Diagnostics) client.WritableZoneRequest {
+func (m *ZoneResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.WritableZoneRequest {
 	p := client.WritableZoneRequest{}
 	p.View = fromInt64Value(m.ViewID)
 	p.Name = m.Name.ValueString()
-	if !m.Status.IsNull() {
+	if !m.Status.IsNull() && !m.Status.IsUnknown() {
 		zonestatus := client.WritableZoneRequestStatus(m.Status.ValueString())
 		p.Status = &zonestatus
 	}
-        if !m.Nameservers.IsNull() {
-		var nameservers []BriefNameServerRequest{}
-		for _, element :=  range m.Nameservers {
-			nameservers = append(nameservers, BriefNameServerRequest {Name:element} )
-			diags.Append(diag.WithPath(path.Root("Nameservers"), d))
+	if !m.NameserverIDs.IsNull() && !m.NameserverIDs.IsUnknown() {
+		nameservers, ds := toIntSetPointer(ctx, m.NameserverIDs)
+		for _, d := range ds {
+			diags.Append(diag.WithPath(path.Root("nameserver_ids"), d))
 		}
 		p.Nameservers = &nameservers
-        }
+	}
 	p.DefaultTtl = fromInt32Value(m.DefaultTTL)
 	p.SoaTtl = fromInt32Value(m.SOATTL)
 	p.SoaExpire = fromInt32Value(m.SOAExpire)
-	p.SoaMinimum  = fromInt32Value(m.SOAMinimum)
+	p.SoaMinimum = fromInt32Value(m.SOAMinimum)
 	p.SoaRefresh = fromInt32Value(m.SOARefresh)
 	p.SoaRetry = fromInt32Value(m.SOARetry)
 	p.SoaMname = fromInt64Value(m.SOAMNameID)
-	p.SoaRname = m.SOARName.ValueStringPointer()
+	p.SoaRname = fromStringValue(m.SOARName)
 	p.SoaSerial = fromInt32Value(m.SOASerial)
 	p.SoaSerialAuto = fromBoolValue(m.SOASerialAuto)
 	p.Description = m.Description.ValueStringPointer()
+	p.Template = fromInt64Value(m.TemplateID)
+	p.Registrar = fromInt64Value(m.RegistrarID)
+	p.Registrant = fromInt64Value(m.RegistrantID)
+	p.AdminC = fromInt64Value(m.AdminCID)
+	p.TechC = fromInt64Value(m.TechCID)
+	p.BillingC = fromInt64Value(m.BillingCID)
+	p.DnssecPolicy = fromInt64Value(m.DNSSECPolicyID)
+	p.InlineSigning = fromBoolValue(m.InlineSigning)
+	p.Rfc2317Prefix = fromStringValue(m.RFC2317Prefix)
+	p.Rfc2317ParentManaged = fromBoolValue(m.RFC2317ParentManaged)
+
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
 
 	return p
 }
 
+// keepRegistryFields copies the registry fields of the zone in Netbox to a
+// full update, which would clear them otherwise. They are not managed by
+// Terraform and may have been updated by the registrar synchronization since
+// the last refresh.
+func keepRegistryFields(p *client.WritableZoneRequest, remote *client.Zone) {
+	p.RegistryDomainId = remote.RegistryDomainId
+	p.ExpirationDate = remote.ExpirationDate
+	p.DomainStatus = nil
+	if remote.DomainStatus != nil {
+		domainStatus := client.WritableZoneRequestDomainStatus(*remote.DomainStatus)
+		p.DomainStatus = &domainStatus
+	}
+}
+
+// reappliesTemplate reports whether the update from prior applies the zone
+// template again, which is only done on request when the template changes.
+func (m *ZoneResourceModel) reappliesTemplate(prior ZoneResourceModel) bool {
+	return m.ReapplyTemplateOnUpdate.ValueBool() && !m.TemplateID.IsNull() && !m.TemplateID.Equal(prior.TemplateID)
+}
+
 // Read from API to resource model
-func (m *ZoneResourceModel) FillFromAPIModel(ctx context.Context, resp *client.Zone, diags diag.Diagnostics) {
-        m.ID = maybeInt64Value(resp.Id)
-	m.ViewID = maybeInt64Value(resp.View.id)
-	m.Name = maybeStringValue(resp.Name)
-	m.Status = maybeStringValue(resp.Status)
-	if resp.Nameservers != nil && len(*resp.Nameservers) > 0 {
-                var ds diag.Diagnostics
-		var nameservers []string{}
-		// api p.Nameservers is a []BriefNameServer
-		for _, element := range p.Nameservers {
-                	nameservers = append(nameservers, element.Name)
+func (m *ZoneResourceModel) FillFromAPIModel(ctx context.Context, resp *client.Zone, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.ViewID = types.Int64Null()
+	m.ViewName = types.StringNull()
+	if resp.View != nil {
+		m.ViewID = maybeInt64Value(resp.View.Id)
+		m.ViewName = types.StringValue(resp.View.Name)
+	}
+	m.Name = maybeStringValue(&resp.Name)
+	m.Status = maybeStringValue((*string)(resp.Status))
+
+	// api resp.Nameservers is a []BriefNameServer
+	nameservers := []int64{}
+	nameserverNames := []string{}
+	if resp.Nameservers != nil {
+		for _, element := range *resp.Nameservers {
+			if element.Id != nil {
+				nameservers = append(nameservers, int64(*element.Id))
+			}
+			nameserverNames = append(nameserverNames, element.Name)
 		}
-		m.Nameservers = nameservers
-                for _, d := range ds {
-                        diags.Append(diag.WithPath(path.Root("nameserver_ids"), d))
-                }
-        }
+	}
+	var ds diag.Diagnostics
+	m.NameserverIDs, ds = types.SetValueFrom(ctx, types.Int64Type, nameservers)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("nameserver_ids"), d))
+	}
+	m.NameserverNames, ds = types.SetValueFrom(ctx, types.StringType, nameserverNames)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("nameserver_names"), d))
+	}
+
 	m.DefaultTTL = maybeInt32Value(resp.DefaultTtl)
 	m.SOATTL = maybeInt32Value(resp.SoaTtl)
-	m.SOAMNameID = maybeInt32Value(resp.SoaMname.id)
+	m.SOAMNameID = types.Int64Null()
+	m.SOAMNameName = types.StringNull()
+	if resp.SoaMname != nil {
+		m.SOAMNameID = maybeInt64Value(resp.SoaMname.Id)
+		m.SOAMNameName = types.StringValue(resp.SoaMname.Name)
+	}
 	m.SOARName = maybeStringValue(resp.SoaRname)
 	m.SOASerial = maybeInt32Value(resp.SoaSerial)
 	m.SOAMinimum = maybeInt32Value(resp.SoaMinimum)
 	m.SOARefresh = maybeInt32Value(resp.SoaRefresh)
 	m.SOARetry = maybeInt32Value(resp.SoaRetry)
-	m.SOAExpire = maybeInt32Value(resp.DefaultTtl)
+	m.SOAExpire = maybeInt32Value(resp.SoaExpire)
 	m.SOASerialAuto = maybeBoolValue(resp.SoaSerialAuto)
 	m.Description = maybeStringValue(resp.Description)
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
+
+	m.RegistrarID = types.Int64Null()
+	if resp.Registrar != nil {
+		m.RegistrarID = maybeInt64Value(resp.Registrar.Id)
+	}
+	m.RegistrantID = types.Int64Null()
+	if resp.Registrant != nil {
+		m.RegistrantID = maybeInt64Value(resp.Registrant.Id)
+	}
+	m.AdminCID = types.Int64Null()
+	if resp.AdminC != nil {
+		m.AdminCID = maybeInt64Value(resp.AdminC.Id)
+	}
+	m.TechCID = types.Int64Null()
+	if resp.TechC != nil {
+		m.TechCID = maybeInt64Value(resp.TechC.Id)
+	}
+	m.BillingCID = types.Int64Null()
+	if resp.BillingC != nil {
+		m.BillingCID = maybeInt64Value(resp.BillingC.Id)
+	}
+	m.RegistryDomainID = maybeStringValue(resp.RegistryDomainId)
+	m.ExpirationDate = types.StringNull()
+	if resp.ExpirationDate != nil {
+		m.ExpirationDate = types.StringValue(resp.ExpirationDate.String())
+	}
+	m.DomainStatus = maybeStringValue((*string)(resp.DomainStatus))
+
+	m.DNSSECPolicyID = types.Int64Null()
+	m.DNSSECPolicyName = types.StringNull()
+	if resp.DnssecPolicy != nil {
+		m.DNSSECPolicyID = maybeInt64Value(resp.DnssecPolicy.Id)
+		m.DNSSECPolicyName = maybeStringValue(&resp.DnssecPolicy.Name)
+	}
+	m.InlineSigning = maybeBoolValue(resp.InlineSigning)
+
+	m.RFC2317Prefix = maybeStringValue(resp.Rfc2317Prefix)
+	m.RFC2317ParentManaged = maybeBoolValue(resp.Rfc2317ParentManaged)
+	m.RFC2317ParentZoneID = types.Int64Null()
+	if resp.Rfc2317ParentZone != nil {
+		m.RFC2317ParentZoneID = maybeInt64Value(resp.Rfc2317ParentZone.Id)
+	}
+	childZones := []int64{}
+	if resp.Rfc2317ChildZones != nil {
+		for _, element := range *resp.Rfc2317ChildZones {
+			if element.Id != nil {
+				childZones = append(childZones, int64(*element.Id))
+			}
+		}
+	}
+	m.RFC2317ChildZoneIDs, ds = types.SetValueFrom(ctx, types.Int64Type, childZones)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("rfc2317_child_zone_ids"), d))
+	}
 }
 
 func (r *ZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -130,83 +354,526 @@ func (r *ZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed:            true,
 				MarkdownDescription: "Zone id in NetBox",
 				PlanModifiers: []planmodifier.Int64{
-					stringplanmodifier.UseStateForUnknown(),
+					int64planmodifier.UseStateForUnknown(),
 				},
 			},
 			"view_id": schema.Int64Attribute{
-				MarkdownDescription: "ID of the DNS View the zone belongs to",
-				Required:            true,
+				MarkdownDescription: "ID of the DNS View the zone belongs to. Defaults to the NetBox default view. Conflicts with `view_name`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("view_name")),
+				},
+			},
+			"view_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the DNS View the zone belongs to. Conflicts with `view_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Zone name",
 				Required:            true,
 			},
 			"status": schema.StringAttribute{
-                                Required: true,
-                                Validators: []validator.String{
-                                        stringvalidator.OneOf(
-                                                string(client.ZoneStatusActive),
-                                                string(client.ZoneStatusDeprecated),
-                                                string(client.ZoneStatusDynamic),
-                                                string(client.ZoneStatusEmpty),
-                                                string(client.ZoneStatusParked),
-                                                string(client.ZoneStatusReserved),
-                                        ),
-                                },
-				MarkdownDescription: `one of "active", "deprecated", "dynamic", "empty", "parked" or "reserved"`,
-
-			},
-        		"nameserver_ids": schema.ListAttribute{
-                                Required: true,
-				MarkdownDescription: `List of nameservers`,
-				ElementType: types.Int64Type,
-			},
-			"defaul_ttl": schema.Int32Attribute{
-                                Optional: true,
-				MarkdownDescription: `Default TTL`,
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(string(client.ZoneStatusActive)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(client.ZoneStatusActive),
+						string(client.ZoneStatusDeprecated),
+						string(client.ZoneStatusDynamic),
+						string(client.ZoneStatusParked),
+						string(client.ZoneStatusReserved),
+					),
+				},
+				MarkdownDescription: `one of "active", "deprecated", "dynamic", "parked" or "reserved". Defaults to "active".`,
+			},
+			"nameserver_ids": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IDs of the nameservers of the zone. Conflicts with `nameserver_names`.",
+				ElementType:         types.Int64Type,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("nameserver_names")),
+				},
+			},
+			"nameserver_names": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Names of the nameservers of the zone. Conflicts with `nameserver_ids`.",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `Default TTL for records in the zone`,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
 			"soa_ttl": schema.Int32Attribute{
-                                Required: true,
-				MarkdownDescription: `Default TTL`,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `TTL of the SOA record`,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
-			"soa_mname": schema.SingleNestedAttribute{
-                                Required: true,
-				MarkdownDescription: "Primary nameserver",
-				Attributes: (*NestedNameserver)(nil).SchemaAttributes(),
+			"soa_mname_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the primary nameserver. Conflicts with `soa_mname_name`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("soa_mname_name")),
+				},
+			},
+			"soa_mname_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the primary nameserver. Conflicts with `soa_mname_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"soa_rname": schema.StringAttribute{
-                                Required: true,
+				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: `zone administrator email address`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"soa_serial": schema.Int32Attribute{
-                                Optional: true,
-				MarkdownDescription: `Default TTL`,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `SOA serial number. Generated by NetBox when "soa_serial_auto" is set.`,
+			},
+			"soa_minimum": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `SOA minimum TTL (negative caching)`,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
 			"soa_refresh": schema.Int32Attribute{
-                                Required: true,
-				MarkdownDescription: `Default TTL`,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `SOA refresh interval`,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
 			"soa_retry": schema.Int32Attribute{
-                                Required: true,
-				MarkdownDescription: `Default TTL`,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `SOA retry interval`,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
 			"soa_expire": schema.Int32Attribute{
-                                Required: true,
-				MarkdownDescription: `Default TTL`,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: `SOA expire time`,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
 			},
 			"soa_serial_auto": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
 				MarkdownDescription: `True if serial is generated automaticaly`,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Zone description",
 				Optional:            true,
 			},
+			"registrar_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the registrar the domain is registered with",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"registrant_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the registration contact of the domain registrant",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"admin_c_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the administrative registration contact of the domain",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"tech_c_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the technical registration contact of the domain",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"billing_c_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the billing registration contact of the domain",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"registry_domain_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the domain in the registry",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_date": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Expiration date of the domain registration (YYYY-MM-DD)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "EPP status of the domain in the registry",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dnssec_policy_id": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "ID of the DNSSEC policy used to sign the zone. Conflicts with `dnssec_policy_name`.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("dnssec_policy_name")),
+				},
+			},
+			"dnssec_policy_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Name of the DNSSEC policy used to sign the zone. Conflicts with `dnssec_policy_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"inline_signing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the zone is signed inline by the nameservers with the DNSSEC policy. Requires `dnssec_policy_id` or `dnssec_policy_name`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rfc2317_prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "IPv4 prefix of at least 25 bits delegated with the zone following RFC 2317, e.g. `192.0.2.0/27`. NetBox creates the CNAME records pointing to the PTR records of the zone in the parent reverse zone.",
+				Validators: []validator.String{
+					rfc2317PrefixValidator{},
+				},
+			},
+			"rfc2317_parent_managed": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "True if the parent reverse zone of the RFC 2317 zone is managed in NetBox, so that the CNAME records are created in it",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"rfc2317_parent_zone_id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the parent reverse zone holding the CNAME records of the RFC 2317 zone",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"rfc2317_child_zone_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "IDs of the RFC 2317 zones delegated from the zone",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the zone template applied when the zone is created. The nameservers, SOA fields, tenant and registration details of the template are used for the attributes not set in the configuration, and records are created from its record templates. The template is not stored in NetBox, it is only applied again on update when `reapply_template_on_update` is set.",
+				Optional:            true,
+			},
+			"reapply_template_on_update": schema.BoolAttribute{
+				MarkdownDescription: "Apply the zone template again when `template_id` changes. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags":              tagsResourceAttribute("zone"),
+			"tags_all":          tagsAllResourceAttribute("zone"),
+			"tenant_id":         tenantIDResourceAttribute("zone"),
+			"custom_fields":     customFieldsResourceAttribute("zone"),
+			"custom_fields_all": customFieldsAllResourceAttribute("zone"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the zone in NetBox, used to detect modifications made outside of Terraform",
+			},
 		},
 	}
 }
 
 func (r *ZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	r.client = configureResourceClient(req, resp)
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// planTemplate sets the attributes not configured unknown when the update
+// from prior applies the zone template again: Netbox sets them from the new
+// template.
+func (m *ZoneResourceModel) planTemplate(prior, config ZoneResourceModel) {
+	if !m.reappliesTemplate(prior) {
+		return
+	}
+	if config.NameserverIDs.IsNull() && config.NameserverNames.IsNull() {
+		m.NameserverIDs = types.SetUnknown(types.Int64Type)
+		m.NameserverNames = types.SetUnknown(types.StringType)
+	}
+	if config.SOAMNameID.IsNull() && config.SOAMNameName.IsNull() {
+		m.SOAMNameID = types.Int64Unknown()
+		m.SOAMNameName = types.StringUnknown()
+	}
+	if config.SOARName.IsNull() {
+		m.SOARName = types.StringUnknown()
+	}
+	if config.RegistrarID.IsNull() {
+		m.RegistrarID = types.Int64Unknown()
+	}
+	if config.RegistrantID.IsNull() {
+		m.RegistrantID = types.Int64Unknown()
+	}
+	if config.AdminCID.IsNull() {
+		m.AdminCID = types.Int64Unknown()
+	}
+	if config.TechCID.IsNull() {
+		m.TechCID = types.Int64Unknown()
+	}
+	if config.BillingCID.IsNull() {
+		m.BillingCID = types.Int64Unknown()
+	}
+	if config.TenantID.IsNull() {
+		m.TenantID = types.Int64Unknown()
+	}
+	if config.DNSSECPolicyID.IsNull() && config.DNSSECPolicyName.IsNull() {
+		m.DNSSECPolicyID = types.Int64Unknown()
+		m.DNSSECPolicyName = types.StringUnknown()
+	}
+}
+
+// planRFC2317 keeps the RFC 2317 parent and child zones of the prior state,
+// unless the attributes Netbox finds them from change.
+func (m *ZoneResourceModel) planRFC2317(prior ZoneResourceModel) {
+	if !m.RFC2317Prefix.Equal(prior.RFC2317Prefix) || !m.RFC2317ParentManaged.Equal(prior.RFC2317ParentManaged) || !m.ViewID.Equal(prior.ViewID) {
+		m.RFC2317ParentZoneID = types.Int64Unknown()
+	}
+	if !m.Name.Equal(prior.Name) || !m.ViewID.Equal(prior.ViewID) {
+		m.RFC2317ChildZoneIDs = types.SetUnknown(types.Int64Type)
+	}
+}
+
+func (r *ZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state, config ZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	creating := req.State.Raw.IsNull()
+	if !creating {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !creating {
+		plan.planTemplate(state, config)
+	}
+
+	r.planNames(ctx, &plan, state, config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !creating {
+		plan.planRFC2317(state)
+	}
+
+	r.planDNSSECPolicy(ctx, &plan, state, config, creating, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.provider.planMetadata(ctx, req, resp)
+}
+
+// planNames resolves the view and nameservers configured by name into their
+// IDs.
+func (r *ZoneResource) planNames(ctx context.Context, plan *ZoneResourceModel, state, config ZoneResourceModel, diags *diag.Diagnostics) {
+	var viewID, nameserverID func(name string) *int
+	if r.provider != nil && r.provider.Client != nil {
+		viewID = func(name string) *int {
+			return r.provider.viewID(ctx, name, true, path.Root("view_name"), diags)
+		}
+		nameserverID = func(name string) *int {
+			return r.provider.nameserverID(ctx, name, true, path.Root("nameserver_names"), diags)
+		}
+	}
+	planNamedID(config.ViewID, config.ViewName, state.ViewID, state.ViewName, &plan.ViewID, &plan.ViewName, viewID)
+	planNamedID(config.SOAMNameID, config.SOAMNameName, state.SOAMNameID, state.SOAMNameName, &plan.SOAMNameID, &plan.SOAMNameName, nameserverID)
+	planNamedIDs(ctx, config.NameserverIDs, config.NameserverNames, state.NameserverIDs, state.NameserverNames, &plan.NameserverIDs, &plan.NameserverNames, nameserverID, diags)
+}
+
+// resolveNames resolves the view and nameservers configured by name that were
+// not found in Netbox at plan time, created by the same apply.
+func (r *ZoneResource) resolveNames(ctx context.Context, data *ZoneResourceModel, diags *diag.Diagnostics) {
+	viewID := func(name string) *int {
+		return r.provider.viewID(ctx, name, false, path.Root("view_name"), diags)
+	}
+	nameserverID := func(name string) *int {
+		return r.provider.nameserverID(ctx, name, false, path.Root("nameserver_names"), diags)
+	}
+	resolveNamedID(&data.ViewID, data.ViewName, viewID)
+	resolveNamedID(&data.SOAMNameID, data.SOAMNameName, nameserverID)
+	resolveNamedIDs(ctx, &data.NameserverIDs, data.NameserverNames, nameserverID, diags)
+}
+
+// planDNSSECPolicy resolves the DNSSEC policy configured by name, and checks
+// the signing settings of the zone against the policy.
+func (r *ZoneResource) planDNSSECPolicy(ctx context.Context, plan *ZoneResourceModel, state, config ZoneResourceModel, creating bool, diags *diag.Diagnostics) {
+	var policy *client.DNSSECPolicy
+	switch {
+	case config.DNSSECPolicyID.IsUnknown() || config.DNSSECPolicyName.IsUnknown():
+		plan.DNSSECPolicyID = types.Int64Unknown()
+		plan.DNSSECPolicyName = types.StringUnknown()
+	case !config.DNSSECPolicyID.IsNull():
+		if !config.DNSSECPolicyID.Equal(state.DNSSECPolicyID) {
+			plan.DNSSECPolicyName = types.StringUnknown()
+		}
+	case !config.DNSSECPolicyName.IsNull():
+		if config.DNSSECPolicyName.Equal(state.DNSSECPolicyName) {
+			break
+		}
+		if r.client == nil {
+			plan.DNSSECPolicyID = types.Int64Unknown()
+			break
+		}
+		policy = lookupDNSSECPolicy(ctx, r.client, config.DNSSECPolicyName.ValueString(), path.Root("dnssec_policy_name"), diags)
+		if policy == nil {
+			return
+		}
+		plan.DNSSECPolicyID = maybeInt64Value(policy.Id)
+	case creating && config.TemplateID.IsNull():
+		// only a template can set the policy of a new zone
+		plan.DNSSECPolicyID = types.Int64Null()
+		plan.DNSSECPolicyName = types.StringNull()
+	}
+
+	if config.InlineSigning.ValueBool() && plan.DNSSECPolicyID.IsNull() {
+		diags.AddAttributeError(path.Root("inline_signing"), "Missing DNSSEC policy", "Inline signing requires a DNSSEC policy, set dnssec_policy_id or dnssec_policy_name.")
+		return
+	}
+
+	// the policy limits the TTL of the records in the zone
+	if plan.DNSSECPolicyID.IsNull() || plan.DNSSECPolicyID.IsUnknown() || plan.DefaultTTL.IsNull() || plan.DefaultTTL.IsUnknown() || r.client == nil {
+		return
+	}
+	if !creating && plan.DNSSECPolicyID.Equal(state.DNSSECPolicyID) && plan.DefaultTTL.Equal(state.DefaultTTL) {
+		return
+	}
+	if policy == nil {
+		policy = r.retrieveDNSSECPolicy(ctx, plan.DNSSECPolicyID, diags)
+	}
+	if policy != nil && policy.MaxZoneTtl != nil && int(plan.DefaultTTL.ValueInt32()) > *policy.MaxZoneTtl {
+		diags.AddAttributeError(path.Root("default_ttl"), "TTL above DNSSEC policy limit", fmt.Sprintf("The default TTL %d of the zone is above the maximum TTL %d of the DNSSEC policy %q.", plan.DefaultTTL.ValueInt32(), *policy.MaxZoneTtl, policy.Name))
+	}
+}
+
+// retrieveDNSSECPolicy fetches the DNSSEC policy of the zone.
+func (r *ZoneResource) retrieveDNSSECPolicy(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.DNSSECPolicy {
+	httpRes, err := r.client.PluginsNetboxDnsDnssecpoliciesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve DNSSEC policy: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsDnssecpoliciesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse DNSSEC policy: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode == http.StatusNotFound {
+			diags.AddAttributeError(path.Root("dnssec_policy_id"), "DNSSEC policy not found", fmt.Sprintf("No DNSSEC policy with ID %d found in Netbox", id.ValueInt64()))
+			return nil
+		}
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// retrieve fetches the zone currently in Netbox. It returns nil without
+// error when the zone does not exist anymore.
+func (r *ZoneResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.Zone {
+	httpRes, err := r.client.PluginsNetboxDnsZonesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve zone: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsZonesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse zone: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, zoneAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
 }
 
 func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -218,7 +885,11 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	r.resolveNames(ctx, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -234,15 +905,17 @@ func (r *ZoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 	if res.JSON201 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, zoneAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON201, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.provider.forgetNames("zone", data.Name)
+
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a resource")
 
@@ -276,11 +949,14 @@ func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		if removeNotFound(ctx, httpRes, resp, "zone", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, zoneAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -289,18 +965,67 @@ func (r *ZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data ZoneResourceModel
+	var data, state ZoneResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	params := data.ToAPIModel(ctx, resp.Diagnostics)
+	r.resolveNames(ctx, &data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	httpRes, err := r.client.PluginsNetboxDnsZonesUpdate(ctx, int(data.ID.ValueInt64()), params)
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the zone was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The zone %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "zone", req, state.LastUpdated, remote.LastUpdated, &current, state.conflictAttributes(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the template fields configured are sent along with the template so that
+	// Netbox does not overwrite them
+	var always []string
+	if data.reappliesTemplate(state) {
+		always = append(always, "template")
+		for field := range zoneTemplateAttributes {
+			always = append(always, field)
+		}
+	} else {
+		params.Template = nil
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		keepRegistryFields(&params, remote)
+		httpRes, err = r.client.PluginsNetboxDnsZonesUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, zoneAPIAttributes, always...)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build zone update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsZonesPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update zone: %s", err))
 		return
@@ -311,15 +1036,17 @@ func (r *ZoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 	if res.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", httpError(httpRes, res.Body))
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, zoneAPIAttributes)
 		return
 	}
 
-	data.FillFromAPIModel(ctx, res.JSON200, resp.Diagnostics)
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.provider.forgetNames("zone", state.Name, data.Name)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -333,6 +1060,22 @@ func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	// Check the zone was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "zone already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "zone", req.State, data.LastUpdated, remote.LastUpdated, &current, data.conflictAttributes(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	httpRes, err := r.client.PluginsNetboxDnsZonesDestroy(ctx, int(data.ID.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy zone: %s", err))
@@ -343,12 +1086,41 @@ func (r *ZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
 		return
 	}
-	if res.StatusCode() != http.StatusNoContent {
+	if !isDeleted(ctx, res.StatusCode(), "zone") {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy zone: %s", string(res.Body)))
 		return
 	}
+	r.provider.forgetNames("zone", data.Name)
 }
 
 func (r *ZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	importByInt64ID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// reapply_template_on_update is not stored in Netbox, an imported zone
+	// gets its default value
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("reapply_template_on_update"), false)...)
+}
+
+// rfc2317PrefixValidator checks the value is an IPv4 prefix that can be
+// delegated following RFC 2317.
+type rfc2317PrefixValidator struct{}
+
+func (v rfc2317PrefixValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 prefix with a length of at least 25 bits"
+}
+
+func (v rfc2317PrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v rfc2317PrefixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	prefix, err := netip.ParsePrefix(req.ConfigValue.ValueString())
+	if err != nil || !prefix.Addr().Is4() || prefix.Bits() < 25 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid RFC 2317 prefix", fmt.Sprintf("%s, got %q", v.Description(ctx), req.ConfigValue.ValueString()))
+	}
 }
@@ -0,0 +1,313 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+func TestZonePlanDNSSECPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count": 1, "results": [{"id": 7, "name": "default", "max_zone_ttl": 3600}]}`))
+	}))
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ZoneResource{client: c}
+
+	tests := map[string]struct {
+		config    ZoneResourceModel
+		wantID    types.Int64
+		wantError path.Path
+	}{
+		"inline signing without policy": {
+			config:    ZoneResourceModel{InlineSigning: types.BoolValue(true), DefaultTTL: types.Int32Value(300)},
+			wantID:    types.Int64Null(),
+			wantError: path.Root("inline_signing"),
+		},
+		"policy by name": {
+			config: ZoneResourceModel{DNSSECPolicyName: types.StringValue("default"), DefaultTTL: types.Int32Value(300)},
+			wantID: types.Int64Value(7),
+		},
+		"default TTL above policy limit": {
+			config:    ZoneResourceModel{DNSSECPolicyName: types.StringValue("default"), DefaultTTL: types.Int32Value(86400)},
+			wantID:    types.Int64Value(7),
+			wantError: path.Root("default_ttl"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := ZoneResourceModel{
+				DNSSECPolicyID:   types.Int64Unknown(),
+				DNSSECPolicyName: types.StringUnknown(),
+				DefaultTTL:       test.config.DefaultTTL,
+			}
+			var diags diag.Diagnostics
+			r.planDNSSECPolicy(context.Background(), &plan, ZoneResourceModel{}, test.config, true, &diags)
+
+			if !plan.DNSSECPolicyID.Equal(test.wantID) {
+				t.Errorf("expected policy ID %s, got %s", test.wantID, plan.DNSSECPolicyID)
+			}
+			if test.wantError.String() == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 {
+				t.Fatalf("expected 1 error, got %v", diags)
+			}
+			if withPath, ok := diags[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(test.wantError) {
+				t.Errorf("expected error on %s, got %v", test.wantError, diags[0])
+			}
+		})
+	}
+}
+
+func TestZonePlanTemplate(t *testing.T) {
+	prior := ZoneResourceModel{
+		NameserverIDs:           types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(3)}),
+		NameserverNames:         types.SetValueMust(types.StringType, []attr.Value{types.StringValue("ns1.example.com")}),
+		SOARName:                types.StringValue("hostmaster.example.com"),
+		RegistrarID:             types.Int64Value(4),
+		TenantID:                types.Int64Value(5),
+		TemplateID:              types.Int64Value(1),
+		ReapplyTemplateOnUpdate: types.BoolValue(true),
+	}
+	// soa_rname is configured, the other template fields come from the
+	// prior template
+	config := ZoneResourceModel{
+		SOARName:                types.StringValue("hostmaster.example.com"),
+		TemplateID:              types.Int64Value(2),
+		ReapplyTemplateOnUpdate: types.BoolValue(true),
+	}
+
+	tests := map[string]struct {
+		templateID  types.Int64
+		reapply     types.Bool
+		wantApplied bool
+	}{
+		"template changed":             {templateID: types.Int64Value(2), reapply: types.BoolValue(true), wantApplied: true},
+		"template unchanged":           {templateID: types.Int64Value(1), reapply: types.BoolValue(true)},
+		"template changed, no reapply": {templateID: types.Int64Value(2), reapply: types.BoolValue(false)},
+		"template removed":             {templateID: types.Int64Null(), reapply: types.BoolValue(true)},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := config
+			config.TemplateID = test.templateID
+			config.ReapplyTemplateOnUpdate = test.reapply
+			plan := prior
+			plan.TemplateID = test.templateID
+			plan.ReapplyTemplateOnUpdate = test.reapply
+
+			plan.planTemplate(prior, config)
+			if got := plan.NameserverIDs.IsUnknown(); got != test.wantApplied {
+				t.Errorf("expected unknown nameserver_ids: %t, got %s", test.wantApplied, plan.NameserverIDs)
+			}
+			if got := plan.RegistrarID.IsUnknown(); got != test.wantApplied {
+				t.Errorf("expected unknown registrar_id: %t, got %s", test.wantApplied, plan.RegistrarID)
+			}
+			if got := plan.TenantID.IsUnknown(); got != test.wantApplied {
+				t.Errorf("expected unknown tenant_id: %t, got %s", test.wantApplied, plan.TenantID)
+			}
+			// the configured values take precedence over the template
+			if !plan.SOARName.Equal(prior.SOARName) {
+				t.Errorf("expected soa_rname %s, got %s", prior.SOARName, plan.SOARName)
+			}
+		})
+	}
+}
+
+func TestZoneToAPIModelTemplate(t *testing.T) {
+	m := ZoneResourceModel{
+		Name:            types.StringValue("example.com"),
+		NameserverIDs:   types.SetUnknown(types.Int64Type),
+		SOAMNameID:      types.Int64Unknown(),
+		SOARName:        types.StringValue("hostmaster.example.com"),
+		TemplateID:      types.Int64Value(2),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	p := m.ToAPIModel(context.Background(), &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	// the fields left to the template are not sent
+	if p.Template == nil || *p.Template != 2 {
+		t.Errorf("expected template 2, got %v", p.Template)
+	}
+	if p.Nameservers != nil || p.SoaMname != nil || p.Registrar != nil || p.Tenant != nil {
+		t.Errorf("expected the template fields to be unset, got nameservers %v, soa_mname %v, registrar %v, tenant %v", p.Nameservers, p.SoaMname, p.Registrar, p.Tenant)
+	}
+	if p.SoaRname == nil || *p.SoaRname != "hostmaster.example.com" {
+		t.Errorf("expected the configured soa_rname, got %v", p.SoaRname)
+	}
+}
+
+func TestZoneRegistration(t *testing.T) {
+	ctx := context.Background()
+	var remote client.Zone
+	if err := json.Unmarshal([]byte(`{
+		"id": 1,
+		"name": "example.com",
+		"view": {"id": 1, "name": "_default_"},
+		"nameservers": [],
+		"registrar": {"id": 2, "name": "Example Registrar"},
+		"registrant": {"id": 3, "contact_id": "EX-1"},
+		"admin_c": {"id": 3, "contact_id": "EX-1"},
+		"tech_c": null,
+		"billing_c": {"id": 4, "contact_id": "EX-2"},
+		"registry_domain_id": "D1234-EXAMPLE",
+		"expiration_date": "2026-06-30",
+		"domain_status": "clientTransferProhibited"
+	}`), &remote); err != nil {
+		t.Fatal(err)
+	}
+
+	m := ZoneResourceModel{
+		Tags:            types.SetNull(types.StringType),
+		TagsAll:         types.SetNull(types.StringType),
+		CustomFields:    types.MapNull(types.StringType),
+		CustomFieldsAll: types.MapNull(types.StringType),
+	}
+	var diags diag.Diagnostics
+	m.FillFromAPIModel(ctx, &remote, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !m.RegistrarID.Equal(types.Int64Value(2)) || !m.RegistrantID.Equal(types.Int64Value(3)) || !m.BillingCID.Equal(types.Int64Value(4)) {
+		t.Errorf("expected registrar 2, registrant 3 and billing contact 4, got %s, %s and %s", m.RegistrarID, m.RegistrantID, m.BillingCID)
+	}
+	if !m.TechCID.IsNull() {
+		t.Errorf("expected no technical contact, got %s", m.TechCID)
+	}
+	if !m.ExpirationDate.Equal(types.StringValue("2026-06-30")) || !m.DomainStatus.Equal(types.StringValue("clientTransferProhibited")) {
+		t.Errorf("expected the registry expiration date and status, got %s and %s", m.ExpirationDate, m.DomainStatus)
+	}
+
+	// the registry fields are not managed: they are only sent by full
+	// updates, with their value in Netbox
+	m.TechCID = types.Int64Value(5)
+	m.ExpirationDate = types.StringValue("2025-01-01")
+	p := m.ToAPIModel(ctx, &diags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if p.Registrar == nil || *p.Registrar != 2 || p.TechC == nil || *p.TechC != 5 {
+		t.Errorf("expected registrar 2 and tech_c 5, got %v and %v", p.Registrar, p.TechC)
+	}
+	if p.RegistryDomainId != nil || p.ExpirationDate != nil || p.DomainStatus != nil {
+		t.Errorf("expected no registry fields, got %v, %v, %v", p.RegistryDomainId, p.ExpirationDate, p.DomainStatus)
+	}
+	keepRegistryFields(&p, &remote)
+	if p.ExpirationDate == nil || p.ExpirationDate.String() != "2026-06-30" {
+		t.Errorf("expected the expiration date in Netbox, got %v", p.ExpirationDate)
+	}
+	if p.RegistryDomainId == nil || *p.RegistryDomainId != "D1234-EXAMPLE" || p.DomainStatus == nil || *p.DomainStatus != "clientTransferProhibited" {
+		t.Errorf("expected the registry fields in Netbox, got %v, %v", p.RegistryDomainId, p.DomainStatus)
+	}
+}
+
+func TestZonePlanRFC2317(t *testing.T) {
+	prior := ZoneResourceModel{
+		Name:                 types.StringValue("0-27.2.0.192.in-addr.arpa"),
+		ViewID:               types.Int64Value(1),
+		RFC2317Prefix:        types.StringValue("192.0.2.0/27"),
+		RFC2317ParentManaged: types.BoolValue(true),
+		RFC2317ParentZoneID:  types.Int64Value(2),
+		RFC2317ChildZoneIDs:  types.SetValueMust(types.Int64Type, []attr.Value{}),
+	}
+
+	tests := map[string]struct {
+		modify        func(m *ZoneResourceModel)
+		wantParent    types.Int64
+		wantUnchanged bool
+	}{
+		"description changed": {
+			modify:        func(m *ZoneResourceModel) { m.Description = types.StringValue("customer") },
+			wantParent:    types.Int64Value(2),
+			wantUnchanged: true,
+		},
+		"prefix changed": {
+			modify:        func(m *ZoneResourceModel) { m.RFC2317Prefix = types.StringValue("192.0.2.32/27") },
+			wantParent:    types.Int64Unknown(),
+			wantUnchanged: true,
+		},
+		"view changed": {
+			modify:     func(m *ZoneResourceModel) { m.ViewID = types.Int64Value(3) },
+			wantParent: types.Int64Unknown(),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			plan := prior
+			test.modify(&plan)
+			plan.planRFC2317(prior)
+			if !plan.RFC2317ParentZoneID.Equal(test.wantParent) {
+				t.Errorf("expected rfc2317_parent_zone_id %s, got %s", test.wantParent, plan.RFC2317ParentZoneID)
+			}
+			if got := plan.RFC2317ChildZoneIDs.Equal(prior.RFC2317ChildZoneIDs); got != test.wantUnchanged {
+				t.Errorf("expected unchanged rfc2317_child_zone_ids: %t, got %s", test.wantUnchanged, plan.RFC2317ChildZoneIDs)
+			}
+		})
+	}
+}
+
+func TestZoneImport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 4, "name": "example.com", "view": {"id": 1, "name": "_default_"}, "status": "active", "nameservers": [], "default_ttl": 86400}`))
+	}))
+	defer server.Close()
+	c, err := client.NewClient(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &ZoneResource{client: c}
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	s := schemaResp.Schema
+
+	importResp := &resource.ImportStateResponse{
+		State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+	}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "4"}, importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatal(importResp.Diagnostics)
+	}
+
+	readResp := &resource.ReadResponse{State: importResp.State}
+	r.Read(ctx, resource.ReadRequest{State: importResp.State}, readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatal(readResp.Diagnostics)
+	}
+	var data ZoneResourceModel
+	if diags := readResp.State.Get(ctx, &data); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !data.Name.Equal(types.StringValue("example.com")) {
+		t.Errorf("expected zone example.com, got %s", data.Name)
+	}
+	// the default is in the state, the first plan shows no change
+	if !data.ReapplyTemplateOnUpdate.Equal(types.BoolValue(false)) {
+		t.Errorf("expected reapply_template_on_update false, got %s", data.ReapplyTemplateOnUpdate)
+	}
+}
@@ -0,0 +1,270 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZoneTemplateDataSource{}
+var _ datasource.DataSourceWithConfigValidators = &ZoneTemplateDataSource{}
+
+func NewZoneTemplateDataSource() datasource.DataSource {
+	return &ZoneTemplateDataSource{}
+}
+
+type ZoneTemplateDataSource struct {
+	client *client.Client
+}
+
+type ZoneTemplateDataSourceModel struct {
+	ID                types.Int64   `tfsdk:"id"`
+	Name              types.String  `tfsdk:"name"`
+	Description       types.String  `tfsdk:"description"`
+	NameserverIDs     []types.Int64 `tfsdk:"nameserver_ids"`
+	SOARName          types.String  `tfsdk:"soa_rname"`
+	SOAMNameID        types.Int64   `tfsdk:"soa_mname_id"`
+	DNSSECPolicyID    types.Int64   `tfsdk:"dnssec_policy_id"`
+	RegistrarID       types.Int64   `tfsdk:"registrar_id"`
+	RegistrantID      types.Int64   `tfsdk:"registrant_id"`
+	AdminCID          types.Int64   `tfsdk:"admin_c_id"`
+	TechCID           types.Int64   `tfsdk:"tech_c_id"`
+	BillingCID        types.Int64   `tfsdk:"billing_c_id"`
+	RecordTemplateIDs []types.Int64 `tfsdk:"record_template_ids"`
+	Tags              types.Set     `tfsdk:"tags"`
+	TenantID          types.Int64   `tfsdk:"tenant_id"`
+	CustomFields      types.Map     `tfsdk:"custom_fields"`
+}
+
+func (m *ZoneTemplateDataSourceModel) FillFromAPIModel(ctx context.Context, resp *client.ZoneTemplate, diags *diag.Diagnostics) {
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	m.SOARName = maybeStringValue(resp.SoaRname)
+
+	m.NameserverIDs = []types.Int64{}
+	if resp.Nameservers != nil {
+		for _, element := range *resp.Nameservers {
+			m.NameserverIDs = append(m.NameserverIDs, maybeInt64Value(element.Id))
+		}
+	}
+
+	m.SOAMNameID = types.Int64Null()
+	if resp.SoaMname != nil {
+		m.SOAMNameID = maybeInt64Value(resp.SoaMname.Id)
+	}
+
+	m.DNSSECPolicyID = types.Int64Null()
+	if resp.DnssecPolicy != nil {
+		m.DNSSECPolicyID = maybeInt64Value(resp.DnssecPolicy.Id)
+	}
+
+	m.RegistrarID = types.Int64Null()
+	if resp.Registrar != nil {
+		m.RegistrarID = maybeInt64Value(resp.Registrar.Id)
+	}
+
+	m.RegistrantID = types.Int64Null()
+	if resp.Registrant != nil {
+		m.RegistrantID = maybeInt64Value(resp.Registrant.Id)
+	}
+
+	m.AdminCID = types.Int64Null()
+	if resp.AdminC != nil {
+		m.AdminCID = maybeInt64Value(resp.AdminC.Id)
+	}
+
+	m.TechCID = types.Int64Null()
+	if resp.TechC != nil {
+		m.TechCID = maybeInt64Value(resp.TechC.Id)
+	}
+
+	m.BillingCID = types.Int64Null()
+	if resp.BillingC != nil {
+		m.BillingCID = maybeInt64Value(resp.BillingC.Id)
+	}
+
+	m.RecordTemplateIDs = []types.Int64{}
+	if resp.RecordTemplates != nil {
+		for _, element := range *resp.RecordTemplates {
+			m.RecordTemplateIDs = append(m.RecordTemplateIDs, maybeInt64Value(element.Id))
+		}
+	}
+	m.Tags = tagSlugsValue(ctx, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = customFieldsValue(ctx, resp.CustomFields, diags)
+}
+
+func (d *ZoneTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_template"
+}
+
+var zoneTemplateDataSchema = map[string]schema.Attribute{
+	"id": schema.Int64Attribute{
+		MarkdownDescription: "ID of the resource in Netbox to use for lookup. Conflicts with `name`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"name": schema.StringAttribute{
+		MarkdownDescription: "Zone template name to use for lookup. Conflicts with `id`.",
+		Optional:            true,
+		Computed:            true,
+	},
+	"description": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Zone template description",
+	},
+	"nameserver_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: "IDs of the nameservers of the zones",
+	},
+	"soa_rname": schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Administrator email address of the zones created from the template",
+	},
+	"soa_mname_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the primary nameserver of the zones",
+	},
+	"dnssec_policy_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the DNSSEC policy of the zones",
+	},
+	"registrar_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the registrar the domains are registered with",
+	},
+	"registrant_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the registrant of the domains",
+	},
+	"admin_c_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the administrative contact of the domains",
+	},
+	"tech_c_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the technical contact of the domains",
+	},
+	"billing_c_id": schema.Int64Attribute{
+		Computed:            true,
+		MarkdownDescription: "ID of the billing contact of the domains",
+	},
+	"record_template_ids": schema.ListAttribute{
+		ElementType:         types.Int64Type,
+		Computed:            true,
+		MarkdownDescription: "IDs of the record templates applied to the zones",
+	},
+	"tags":          tagsDataSourceAttribute(),
+	"tenant_id":     tenantIDDataSourceAttribute(),
+	"custom_fields": customFieldsDataSourceAttribute(),
+}
+
+func (d *ZoneTemplateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "zone template data source",
+		Attributes:          zoneTemplateDataSchema,
+	}
+}
+
+func (d *ZoneTemplateDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+func (d *ZoneTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *ZoneTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZoneTemplateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var obj *client.ZoneTemplate
+	if !data.ID.IsNull() {
+		obj = d.retrieve(ctx, data.ID, &resp.Diagnostics)
+	} else {
+		obj = d.lookupByName(ctx, data.Name, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.FillFromAPIModel(ctx, obj, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *ZoneTemplateDataSource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.ZoneTemplate {
+	httpRes, err := d.client.PluginsNetboxDnsZonetemplatesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve zone template: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsZonetemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse zone template: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	return res.JSON200
+}
+
+// lookupByName finds the zone template with the given name, which must match
+// exactly one zone template.
+func (d *ZoneTemplateDataSource) lookupByName(ctx context.Context, name types.String, diags *diag.Diagnostics) *client.ZoneTemplate {
+	params := client.PluginsNetboxDnsZonetemplatesListParams{
+		Name: &[]string{name.ValueString()},
+	}
+	httpRes, err := d.client.PluginsNetboxDnsZonetemplatesList(ctx, &params)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to list zone templates: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsZonetemplatesListResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse zone templates: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		diags.AddError("Client Error", httpError(httpRes, res.Body))
+		return nil
+	}
+	switch len(res.JSON200.Results) {
+	case 0:
+		diags.AddAttributeError(path.Root("name"), "Zone template not found", fmt.Sprintf("No zone template named %q found in Netbox", name.ValueString()))
+		return nil
+	case 1:
+		return &res.JSON200.Results[0]
+	default:
+		diags.AddAttributeError(path.Root("name"), "Multiple zone templates found", fmt.Sprintf("%d zone templates named %q found in Netbox", len(res.JSON200.Results), name.ValueString()))
+		return nil
+	}
+}
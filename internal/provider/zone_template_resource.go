@@ -0,0 +1,495 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ZoneTemplateResource{}
+var _ resource.ResourceWithImportState = &ZoneTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &ZoneTemplateResource{}
+
+func NewZoneTemplateResource() resource.Resource {
+	return &ZoneTemplateResource{}
+}
+
+// ZoneTemplateResource defines the resource implementation.
+type ZoneTemplateResource struct {
+	client   *client.Client
+	provider *configuredProvider
+}
+
+// ZoneTemplateResourceModel describes the resource data model.
+type ZoneTemplateResourceModel struct {
+	ID                types.Int64  `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	NameserverIDs     types.Set    `tfsdk:"nameserver_ids"`
+	SOAMNameID        types.Int64  `tfsdk:"soa_mname_id"`
+	SOARName          types.String `tfsdk:"soa_rname"`
+	DNSSECPolicyID    types.Int64  `tfsdk:"dnssec_policy_id"`
+	RegistrarID       types.Int64  `tfsdk:"registrar_id"`
+	RegistrantID      types.Int64  `tfsdk:"registrant_id"`
+	AdminCID          types.Int64  `tfsdk:"admin_c_id"`
+	TechCID           types.Int64  `tfsdk:"tech_c_id"`
+	BillingCID        types.Int64  `tfsdk:"billing_c_id"`
+	RecordTemplateIDs types.Set    `tfsdk:"record_template_ids"`
+	Tags              types.Set    `tfsdk:"tags"`
+	TagsAll           types.Set    `tfsdk:"tags_all"`
+	TenantID          types.Int64  `tfsdk:"tenant_id"`
+	CustomFields      types.Map    `tfsdk:"custom_fields"`
+	CustomFieldsAll   types.Map    `tfsdk:"custom_fields_all"`
+	LastUpdated       types.String `tfsdk:"last_updated"`
+}
+
+// zoneTemplateAPIAttributes maps Netbox API fields to zone template resource attributes.
+var zoneTemplateAPIAttributes = map[string]string{
+	"name":             "name",
+	"description":      "description",
+	"nameservers":      "nameserver_ids",
+	"soa_mname":        "soa_mname_id",
+	"soa_rname":        "soa_rname",
+	"dnssec_policy":    "dnssec_policy_id",
+	"registrar":        "registrar_id",
+	"registrant":       "registrant_id",
+	"admin_c":          "admin_c_id",
+	"tech_c":           "tech_c_id",
+	"billing_c":        "billing_c_id",
+	"record_templates": "record_template_ids",
+	"tags":             "tags",
+	"tenant":           "tenant_id",
+	"custom_fields":    "custom_fields",
+}
+
+func (m *ZoneTemplateResourceModel) ToAPIModel(ctx context.Context, diags *diag.Diagnostics) client.ZoneTemplateRequest {
+	p := client.ZoneTemplateRequest{}
+	p.Name = m.Name.ValueString()
+	p.Description = m.Description.ValueStringPointer()
+	if !m.NameserverIDs.IsNull() && !m.NameserverIDs.IsUnknown() {
+		ids, ds := toIntSetPointer(ctx, m.NameserverIDs)
+		for _, d := range ds {
+			diags.Append(diag.WithPath(path.Root("nameserver_ids"), d))
+		}
+		p.Nameservers = &ids
+	}
+	p.SoaRname = fromStringValue(m.SOARName)
+	p.SoaMname = fromInt64Value(m.SOAMNameID)
+	p.DnssecPolicy = fromInt64Value(m.DNSSECPolicyID)
+	p.Registrar = fromInt64Value(m.RegistrarID)
+	p.Registrant = fromInt64Value(m.RegistrantID)
+	p.AdminC = fromInt64Value(m.AdminCID)
+	p.TechC = fromInt64Value(m.TechCID)
+	p.BillingC = fromInt64Value(m.BillingCID)
+	if !m.RecordTemplateIDs.IsNull() && !m.RecordTemplateIDs.IsUnknown() {
+		ids, ds := toIntSetPointer(ctx, m.RecordTemplateIDs)
+		for _, d := range ds {
+			diags.Append(diag.WithPath(path.Root("record_template_ids"), d))
+		}
+		p.RecordTemplates = &ids
+	}
+	p.Tags = fromTagsValue(ctx, m.TagsAll, diags)
+	p.Tenant = fromInt64Value(m.TenantID)
+	p.CustomFields = fromCustomFieldsValue(ctx, m.CustomFieldsAll, diags)
+
+	return p
+}
+
+func (m *ZoneTemplateResourceModel) FillFromAPIModel(ctx context.Context, resp *client.ZoneTemplate, diags *diag.Diagnostics) {
+	var ds diag.Diagnostics
+	m.ID = maybeInt64Value(resp.Id)
+	m.Name = maybeStringValue(&resp.Name)
+	m.Description = maybeStringValue(resp.Description)
+	nameservers := []int64{}
+	if resp.Nameservers != nil {
+		for _, element := range *resp.Nameservers {
+			if element.Id != nil {
+				nameservers = append(nameservers, int64(*element.Id))
+			}
+		}
+	}
+	m.NameserverIDs, ds = types.SetValueFrom(ctx, types.Int64Type, nameservers)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("nameserver_ids"), d))
+	}
+	m.SOARName = maybeStringValue(resp.SoaRname)
+	m.SOAMNameID = types.Int64Null()
+	if resp.SoaMname != nil {
+		m.SOAMNameID = maybeInt64Value(resp.SoaMname.Id)
+	}
+	m.DNSSECPolicyID = types.Int64Null()
+	if resp.DnssecPolicy != nil {
+		m.DNSSECPolicyID = maybeInt64Value(resp.DnssecPolicy.Id)
+	}
+	m.RegistrarID = types.Int64Null()
+	if resp.Registrar != nil {
+		m.RegistrarID = maybeInt64Value(resp.Registrar.Id)
+	}
+	m.RegistrantID = types.Int64Null()
+	if resp.Registrant != nil {
+		m.RegistrantID = maybeInt64Value(resp.Registrant.Id)
+	}
+	m.AdminCID = types.Int64Null()
+	if resp.AdminC != nil {
+		m.AdminCID = maybeInt64Value(resp.AdminC.Id)
+	}
+	m.TechCID = types.Int64Null()
+	if resp.TechC != nil {
+		m.TechCID = maybeInt64Value(resp.TechC.Id)
+	}
+	m.BillingCID = types.Int64Null()
+	if resp.BillingC != nil {
+		m.BillingCID = maybeInt64Value(resp.BillingC.Id)
+	}
+	recordTemplates := []int64{}
+	if resp.RecordTemplates != nil {
+		for _, element := range *resp.RecordTemplates {
+			if element.Id != nil {
+				recordTemplates = append(recordTemplates, int64(*element.Id))
+			}
+		}
+	}
+	m.RecordTemplateIDs, ds = types.SetValueFrom(ctx, types.Int64Type, recordTemplates)
+	for _, d := range ds {
+		diags.Append(diag.WithPath(path.Root("record_template_ids"), d))
+	}
+	m.Tags = managedTagsValue(ctx, m.Tags, resp.Tags, diags)
+	m.TagsAll = allTagsValue(ctx, m.TagsAll, resp.Tags, diags)
+	m.TenantID = types.Int64Null()
+	if resp.Tenant != nil {
+		m.TenantID = maybeInt64Value(resp.Tenant.Id)
+	}
+	m.CustomFields = managedCustomFieldsValue(ctx, m.CustomFields, resp.CustomFields, diags)
+	m.CustomFieldsAll = managedCustomFieldsValue(ctx, m.CustomFieldsAll, resp.CustomFields, diags)
+	m.LastUpdated = maybeTimeValue(resp.LastUpdated)
+}
+
+func (r *ZoneTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_template"
+}
+
+func (r *ZoneTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Zone template resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Zone template id in NetBox",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Zone template name",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Zone template description",
+				Optional:            true,
+			},
+			"nameserver_ids": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IDs of the nameservers of the zones created from the template",
+				ElementType:         types.Int64Type,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+			},
+			"soa_mname_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the primary nameserver of the zones created from the template",
+			},
+			"soa_rname": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Administrator email address of the zones created from the template",
+			},
+			"dnssec_policy_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the DNSSEC policy of the zones created from the template",
+			},
+			"registrar_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the registrar the domains are registered with",
+			},
+			"registrant_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the registration contact of the domain registrant",
+			},
+			"admin_c_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the administrative registration contact of the domains",
+			},
+			"tech_c_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the technical registration contact of the domains",
+			},
+			"billing_c_id": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the billing registration contact of the domains",
+			},
+			"record_template_ids": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "IDs of the record templates used to create records in the zones created from the template",
+				ElementType:         types.Int64Type,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+			},
+			"tags":              tagsResourceAttribute("zone template"),
+			"tags_all":          tagsAllResourceAttribute("zone template"),
+			"tenant_id":         tenantIDResourceAttribute("zone template"),
+			"custom_fields":     customFieldsResourceAttribute("zone template"),
+			"custom_fields_all": customFieldsAllResourceAttribute("zone template"),
+			"last_updated": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Time of the last modification of the zone template in NetBox, used to detect modifications made outside of Terraform",
+			},
+		},
+	}
+}
+
+func (r *ZoneTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.provider = configureResourceProvider(req, resp)
+	if r.provider != nil {
+		r.client = r.provider.Client
+	}
+}
+
+// retrieve fetches the zone template currently in Netbox. It returns nil without
+// error when the zone template does not exist anymore.
+func (r *ZoneTemplateResource) retrieve(ctx context.Context, id types.Int64, diags *diag.Diagnostics) *client.ZoneTemplate {
+	httpRes, err := r.client.PluginsNetboxDnsZonetemplatesRetrieve(ctx, int(id.ValueInt64()))
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to retrieve zone template: %s", err))
+		return nil
+	}
+	res, err := client.ParsePluginsNetboxDnsZonetemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("failed to parse zone template: %s", err))
+		return nil
+	}
+	if res.JSON200 == nil {
+		if httpRes.StatusCode != http.StatusNotFound {
+			addHTTPError(diags, httpRes, res.Body, zoneTemplateAPIAttributes)
+		}
+		return nil
+	}
+	return res.JSON200
+}
+
+func (r *ZoneTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ZoneTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsZonetemplatesCreate(ctx, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create zone template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsZonetemplatesCreateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse zone template response: %s", err))
+		return
+	}
+	if res.JSON201 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, zoneTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON201, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ZoneTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Internal Error", "Missing ID value")
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsZonetemplatesRetrieve(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to retrieve zone template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsZonetemplatesRetrieveResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse zone template: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		if removeNotFound(ctx, httpRes, resp, "zone template", data.ID, data.Name) {
+			return
+		}
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, zoneTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ZoneTemplateResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params := data.ToAPIModel(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	params.Tags = clearRemovedTags(params.Tags, state.TagsAll)
+	params.CustomFields = clearRemovedCustomFields(params.CustomFields, state.CustomFieldsAll)
+
+	// Check the zone template was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, state.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		resp.Diagnostics.AddError("Conflict", fmt.Sprintf("The zone template %d was deleted in Netbox after Terraform last read it.", state.ID.ValueInt64()))
+		return
+	}
+	current := state
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	keepRemote := checkUpdateConflict(ctx, r.provider.OnConflict, "zone template", req, state.LastUpdated, remote.LastUpdated, &current, zoneTemplateAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var httpRes *http.Response
+	var err error
+	if r.provider.FullUpdates && !keepRemote {
+		httpRes, err = r.client.PluginsNetboxDnsZonetemplatesUpdate(ctx, int(data.ID.ValueInt64()), params)
+	} else {
+		var body io.Reader
+		body, err = partialUpdateBody(req, params, zoneTemplateAPIAttributes)
+		if err != nil {
+			resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("failed to build zone template update: %s", err))
+			return
+		}
+		httpRes, err = r.client.PluginsNetboxDnsZonetemplatesPartialUpdateWithBody(ctx, int(data.ID.ValueInt64()), "application/json", body)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to update zone template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsZonetemplatesUpdateResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse zone template response: %s", err))
+		return
+	}
+	if res.JSON200 == nil {
+		addHTTPError(&resp.Diagnostics, httpRes, res.Body, zoneTemplateAPIAttributes)
+		return
+	}
+
+	data.FillFromAPIModel(ctx, res.JSON200, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ZoneTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ZoneTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Check the zone template was not modified in Netbox since Terraform last read it
+	remote := r.retrieve(ctx, data.ID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if remote == nil {
+		tflog.Info(ctx, "zone template already deleted", map[string]interface{}{"id": data.ID.ValueInt64()})
+		return
+	}
+	current := data
+	current.FillFromAPIModel(ctx, remote, &resp.Diagnostics)
+	checkDeleteConflict(ctx, r.provider.OnConflict, "zone template", req.State, data.LastUpdated, remote.LastUpdated, &current, zoneTemplateAPIAttributes, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpRes, err := r.client.PluginsNetboxDnsZonetemplatesDestroy(ctx, int(data.ID.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy zone template: %s", err))
+		return
+	}
+	res, err := client.ParsePluginsNetboxDnsZonetemplatesDestroyResponse(httpRes)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to parse response: %s", err))
+		return
+	}
+	if !isDeleted(ctx, res.StatusCode(), "zone template") {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to destroy zone template: %s", string(res.Body)))
+		return
+	}
+}
+
+// ModifyPlan applies the provider default tags, tenant and custom fields.
+func (r *ZoneTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	r.provider.planMetadata(ctx, req, resp)
+}
+
+func (r *ZoneTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importByInt64ID(ctx, req, resp)
+}
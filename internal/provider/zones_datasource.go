@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/jean1/terraform-provider-netbox-dns/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ZonesDataSource{}
+
+func NewZonesDataSource() datasource.DataSource {
+	return &ZonesDataSource{}
+}
+
+type ZonesDataSource struct {
+	client *client.Client
+}
+
+type ZonesDataSourceModel struct {
+	Name           types.String          `tfsdk:"name"`
+	NameContains   types.String          `tfsdk:"name_contains"`
+	NameStartsWith types.String          `tfsdk:"name_starts_with"`
+	NameNot        types.String          `tfsdk:"name_not"`
+	ViewID         types.Int64           `tfsdk:"view_id"`
+	View           types.String          `tfsdk:"view"`
+	Status         types.String          `tfsdk:"status"`
+	Tags           types.Set             `tfsdk:"tags"`
+	TenantID       types.Int64           `tfsdk:"tenant_id"`
+	NameserverID   types.Int64           `tfsdk:"nameserver_id"`
+	RegistrarID    types.Int64           `tfsdk:"registrar_id"`
+	Zones          []ZoneDataSourceModel `tfsdk:"zones"`
+}
+
+// ToListParams returns the Netbox list filters matching the configured filters.
+func (m *ZonesDataSourceModel) ToListParams(ctx context.Context, diags *diag.Diagnostics) client.PluginsNetboxDnsZonesListParams {
+	params := client.PluginsNetboxDnsZonesListParams{}
+	params.Name = stringFilter(m.Name)
+	params.NameIc = stringFilter(m.NameContains)
+	params.NameIsw = stringFilter(m.NameStartsWith)
+	params.NameN = stringFilter(m.NameNot)
+	params.ViewId = intFilter(m.ViewID)
+	params.View = stringFilter(m.View)
+	params.Status = stringFilter(m.Status)
+	params.Tag = tagsFilter(ctx, m.Tags, diags)
+	params.TenantId = intFilter(m.TenantID)
+	params.NameserverId = intFilter(m.NameserverID)
+	params.RegistrarId = intFilter(m.RegistrarID)
+	return params
+}
+
+func (d *ZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zones"
+}
+
+func (d *ZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "DNS Zones data source, returning the zones matching all the filters set",
+		Attributes: map[string]schema.Attribute{
+			"name":             filterAttribute("Name of the zones"),
+			"name_contains":    filterAttribute("Part of the name of the zones, case insensitive"),
+			"name_starts_with": filterAttribute("Start of the name of the zones, case insensitive"),
+			"name_not":         filterAttribute("Name the zones must not have"),
+			"view_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the view of the zones",
+				Optional:            true,
+			},
+			"view":   filterAttribute("Name of the view of the zones"),
+			"status": filterAttribute(`Status of the zones, e.g. "active"`),
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Slugs of tags the zones must all have",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"tenant_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the tenant of the zones",
+				Optional:            true,
+			},
+			"nameserver_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of a nameserver of the zones",
+				Optional:            true,
+			},
+			"registrar_id": schema.Int64Attribute{
+				MarkdownDescription: "ID of the registrar of the zones",
+				Optional:            true,
+			},
+			"zones": schema.ListNestedAttribute{
+				MarkdownDescription: "Zones matching the filters, with the attributes of the `netboxdns_zone` data source",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: computedAttributes(zoneDataSchema),
+				},
+			},
+		},
+	}
+}
+
+func (d *ZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureDataSourceClient(req, resp)
+}
+
+func (d *ZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ZonesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := data.ToListParams(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	zones := listZones(ctx, d.client, params, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Zones = make([]ZoneDataSourceModel, len(zones))
+	for i := range zones {
+		data.Zones[i].FillFromAPIModel(ctx, &zones[i], &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}